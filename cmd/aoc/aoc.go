@@ -0,0 +1,609 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jambolo/advent-of-code-2015/internal/client"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days"
+	"github.com/jambolo/advent-of-code-2015/internal/report"
+	"github.com/jambolo/advent-of-code-2015/internal/scaffold"
+	"github.com/jambolo/advent-of-code-2015/internal/server"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+	"github.com/jambolo/advent-of-code-2015/internal/watch"
+)
+
+const usage = `Usage: aoc <command> [arguments]
+
+Commands:
+  run <day|all> [flags]       Run the solver for a day, or for every registered day of the year
+  verify [day|all] [flags]    Check the answers of a day, or of every day of the year, against the expected answers
+  new <day> [flags]           Create the solver, test, and example files of a new day
+  fetch <day|all> [flags]     Download the input of a day, or of every day of the year, unless it is already cached
+  submit <day> <part> [flags] Submit the answer to a part of a day, unless it is known to be wrong
+  report [flags]              Run every day of the year and update the answer and algorithm tables of the README
+  watch <day> [flags]         Run a day again whenever its source files or input change
+  serve [flags]               Serve a page listing the days and an API that solves a part for a posted input
+
+The year is selected by the -year flag of each command and defaults to the latest registered year.
+Run "aoc run <day> -h" for the flags of the run command.
+`
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("aoc: ")
+
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	// An interrupt stops the solver that is running instead of killing the process.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var err error
+	switch os.Args[1] {
+	case "run":
+		err = run(ctx, os.Args[2:])
+	case "verify":
+		err = verify(ctx, os.Args[2:])
+	case "new":
+		err = newDay(os.Args[2:])
+	case "fetch":
+		err = fetch(ctx, os.Args[2:])
+	case "submit":
+		err = submit(ctx, os.Args[2:])
+	case "report":
+		err = writeReport(ctx, os.Args[2:])
+	case "watch":
+		err = watchDay(ctx, os.Args[2:])
+	case "serve":
+		err = serve(ctx, os.Args[2:])
+	case "help", "-h", "-help", "--help":
+		fmt.Print(usage)
+		return
+	default:
+		err = fmt.Errorf("unknown command %q", os.Args[1])
+	}
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run implements the run command.
+func run(ctx context.Context, args []string) error {
+	if len(args) < 1 {
+		return errors.New("run: missing day")
+	}
+	target := args[0]
+
+	day, err := parseTarget(target)
+	if err != nil {
+		return fmt.Errorf("run: %w", err)
+	}
+
+	// A single day runs part 1 by default and accepts the flags of its solver. All days run both parts by default.
+	parts := []int{1, 2}
+	if day != 0 {
+		parts = []int{1}
+	}
+	params, rest, err := setup.Parameters(day, args[1:])
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return fmt.Errorf("run: unexpected arguments %v", rest)
+	}
+	if target == "all" && params.Path != "" {
+		return errors.New("run: -file cannot be used with all")
+	}
+	if target == "all" && params.Example != 0 {
+		return errors.New("run: -example cannot be used with all")
+	}
+	if params.Jobs > 1 && (target != "all" || params.Bench > 0) {
+		return errors.New("run: -j can only be used with all, and not with -bench")
+	}
+	if params.Parts == nil {
+		params.Parts = parts
+	}
+
+	days := targetDays(params.Year, day)
+
+	setup.SetLogging(os.Stderr, params.LogLevel)
+
+	stop, err := params.Profiles.Start()
+	if err != nil {
+		return fmt.Errorf("run: %w", err)
+	}
+	switch {
+	case params.Bench > 0:
+		err = bench(ctx, days, params)
+	case target == "all":
+		err = solveAll(ctx, days, params)
+	default:
+		err = solve(ctx, days, params)
+	}
+	if stopErr := stop(); stopErr != nil && err == nil {
+		err = fmt.Errorf("run: %w", stopErr)
+	}
+	return err
+}
+
+// solve implements the run command without the -bench flag.
+func solve(ctx context.Context, days []int, params setup.Params) error {
+	failed := false
+	for _, day := range days {
+		results, err := setup.Run(ctx, day, params)
+		if err != nil {
+			log.Print(err)
+			failed = true
+			continue
+		}
+		if setup.Failed(results) {
+			failed = true
+		}
+		if err := setup.Report(os.Stdout, params.Output, results); err != nil {
+			return err
+		}
+		if params.Example != 0 {
+			ok, err := checkExample(day, params, results)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				failed = true
+			}
+		}
+	}
+	if failed {
+		return errors.New("run: one or more parts failed")
+	}
+	return nil
+}
+
+// solveAll implements the run command for all days without the -bench flag. The days are run on a pool of params.Jobs
+// goroutines and their results are shown together in a summary table.
+func solveAll(ctx context.Context, days []int, params setup.Params) error {
+	results := setup.RunAll(ctx, days, params, params.Jobs)
+	if err := setup.ReportAll(os.Stdout, params.Output, results); err != nil {
+		return err
+	}
+	for _, r := range results {
+		if setup.Failed(r) {
+			return errors.New("run: one or more parts failed")
+		}
+	}
+	return nil
+}
+
+// bench implements the run command with the -bench flag.
+func bench(ctx context.Context, days []int, params setup.Params) error {
+	failed := false
+	for _, day := range days {
+		stats, err := setup.Bench(ctx, day, params, params.Bench)
+		if err != nil {
+			log.Print(err)
+			failed = true
+			continue
+		}
+		if setup.BenchFailed(stats) {
+			failed = true
+		}
+		if err := setup.ReportBench(os.Stdout, params.Output, stats); err != nil {
+			return err
+		}
+	}
+	if failed {
+		return errors.New("run: one or more parts failed")
+	}
+	return nil
+}
+
+// checkExample checks the results of an example against its expected answers, if it has any, and returns false if any
+// of them fail. The checks are written to stderr when the output is JSON so that the output remains valid.
+func checkExample(day int, params setup.Params, results []setup.Result) (bool, error) {
+	checks, err := setup.CheckExample(params.DataDir, params.Year, day, params.Example, results)
+	if err != nil {
+		return false, fmt.Errorf("run: %w", err)
+	}
+	w := os.Stdout
+	if params.Output == setup.OutputJSON {
+		w = os.Stderr
+	}
+	if err := setup.WriteChecks(w, checks); err != nil {
+		return false, err
+	}
+	for _, c := range checks {
+		if c.Status == setup.Fail {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// verify implements the verify command.
+func verify(ctx context.Context, args []string) error {
+	target := "all"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		target = args[0]
+		args = args[1:]
+	}
+
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	year := fs.Int("year", setup.DefaultYear(), "Year of the puzzles")
+	dataDir := fs.String("data", "", "Root `directory` of the puzzle data (default $AOC_DATA_DIR or data)")
+	answersPath := fs.String("answers", "", "Path to the expected answers file (default <data>/<year>/answers.txt)")
+	timeout := fs.Duration("timeout", 0, "Stop a run of a day that takes longer than `duration` (default no limit)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *dataDir == "" {
+		*dataDir = setup.DataDir()
+	}
+	if *answersPath == "" {
+		*answersPath = setup.AnswersPath(*dataDir, *year)
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("verify: unexpected arguments %v", fs.Args())
+	}
+
+	day, err := parseTarget(target)
+	if err != nil {
+		return fmt.Errorf("verify: %w", err)
+	}
+	answers, err := setup.LoadAnswers(*answersPath, *year)
+	if err != nil {
+		return fmt.Errorf("verify: %w", err)
+	}
+
+	counts := make(map[setup.Status]int)
+	parts := []int{1, 2}
+	for _, day := range targetDays(*year, day) {
+		params := setup.Params{Year: *year, DataDir: *dataDir, Parts: parts, Timeout: *timeout}
+		results, err := setup.Run(ctx, day, params)
+		if err != nil {
+			// Both parts fail if the input cannot be parsed.
+			results = nil
+			for _, part := range parts {
+				results = append(results, setup.Result{Year: *year, Day: day, Part: part, Err: err})
+			}
+		}
+		checks := setup.Verify(results, answers)
+		if err := setup.WriteChecks(os.Stdout, checks); err != nil {
+			return err
+		}
+		for _, c := range checks {
+			counts[c.Status]++
+		}
+	}
+
+	fmt.Printf("%d passed, %d failed, %d missing\n", counts[setup.Pass], counts[setup.Fail], counts[setup.Missing])
+	if counts[setup.Fail] > 0 {
+		return errors.New("verify: one or more answers are wrong")
+	}
+	return nil
+}
+
+// newDay implements the new command.
+func newDay(args []string) error {
+	if len(args) < 1 {
+		return errors.New("new: missing day")
+	}
+	day, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("new: invalid day %q", args[0])
+	}
+
+	fs := flag.NewFlagSet("new", flag.ContinueOnError)
+	year := fs.Int("year", setup.DefaultYear(), "Year of the puzzle")
+	root := fs.String("root", ".", "Root `directory` of the module")
+	dataDir := fs.String("data", "", "Root `directory` of the puzzle data (default $AOC_DATA_DIR or data)")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("new: unexpected arguments %v", fs.Args())
+	}
+	if *dataDir == "" {
+		*dataDir = setup.DataDir()
+	}
+
+	paths, err := scaffold.New(*root, *dataDir, *year, day)
+	for _, path := range paths {
+		fmt.Println(path)
+	}
+	if err != nil {
+		return fmt.Errorf("new: %w", err)
+	}
+	return nil
+}
+
+// fetch implements the fetch command.
+func fetch(ctx context.Context, args []string) error {
+	if len(args) < 1 {
+		return errors.New("fetch: missing day")
+	}
+	day, err := parseTarget(args[0])
+	if err != nil {
+		return fmt.Errorf("fetch: %w", err)
+	}
+
+	fs := flag.NewFlagSet("fetch", flag.ContinueOnError)
+	year := fs.Int("year", setup.DefaultYear(), "Year of the puzzles")
+	dataDir := fs.String("data", "", "Root `directory` of the puzzle data (default $AOC_DATA_DIR or data)")
+	baseURL := fs.String("url", "", "Base `URL` of the website (default $AOC_BASE_URL or "+client.DefaultBaseURL+")")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("fetch: unexpected arguments %v", fs.Args())
+	}
+	if *dataDir == "" {
+		*dataDir = setup.DataDir()
+	}
+	if *baseURL == "" {
+		*baseURL = client.BaseURL()
+	}
+
+	c := client.New(*baseURL)
+	for _, day := range targetDays(*year, day) {
+		path := setup.DefaultPath(*dataDir, *year, day)
+		fetched, err := c.Fetch(ctx, *year, day, path)
+		if err != nil {
+			return fmt.Errorf("fetch: %d day %d: %w", *year, day, err)
+		}
+		if fetched {
+			fmt.Println("fetched", path)
+		} else {
+			fmt.Println("cached ", path)
+		}
+	}
+	return nil
+}
+
+// submit implements the submit command.
+func submit(ctx context.Context, args []string) error {
+	if len(args) < 2 {
+		return errors.New("submit: missing day or part")
+	}
+	day, err := strconv.Atoi(args[0])
+	if err != nil || day < 1 {
+		return fmt.Errorf("submit: invalid day %q", args[0])
+	}
+	part, err := strconv.Atoi(args[1])
+	if err != nil || part < 1 || part > 2 {
+		return fmt.Errorf("submit: invalid part %q, must be 1 or 2", args[1])
+	}
+
+	fs := flag.NewFlagSet("submit", flag.ContinueOnError)
+	year := fs.Int("year", setup.DefaultYear(), "Year of the puzzles")
+	dataDir := fs.String("data", "", "Root `directory` of the puzzle data (default $AOC_DATA_DIR or data)")
+	baseURL := fs.String("url", "", "Base `URL` of the website (default $AOC_BASE_URL or "+client.DefaultBaseURL+")")
+	historyPath := fs.String("history", "", "Path to the submission history file (default <data>/<year>/submissions.txt)")
+	timeout := fs.Duration("timeout", 0, "Stop the solver if it takes longer than `duration` (default no limit)")
+	if err := fs.Parse(args[2:]); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("submit: unexpected arguments %v", fs.Args())
+	}
+	if *dataDir == "" {
+		*dataDir = setup.DataDir()
+	}
+	if *baseURL == "" {
+		*baseURL = client.BaseURL()
+	}
+	if *historyPath == "" {
+		*historyPath = client.HistoryPath(*dataDir, *year)
+	}
+
+	results, err := setup.Run(ctx, day, setup.Params{Year: *year, DataDir: *dataDir, Parts: []int{part}, Timeout: *timeout})
+	if err != nil {
+		return fmt.Errorf("submit: %w", err)
+	}
+	if results[0].Err != nil {
+		return fmt.Errorf("submit: %d day %d part %d: %w", *year, day, part, results[0].Err)
+	}
+	answer := fmt.Sprint(results[0].Answer)
+	fmt.Printf("%d day %d part %d: %s\n", *year, day, part, answer)
+
+	history, err := client.LoadHistory(*historyPath)
+	if err != nil {
+		return fmt.Errorf("submit: %w", err)
+	}
+	now := time.Now()
+	if err := history.Check(day, part, answer, now); err != nil {
+		return fmt.Errorf("submit: not submitted: %w", err)
+	}
+
+	response, err := client.New(*baseURL).Submit(ctx, *year, day, part, answer)
+	if err != nil {
+		return fmt.Errorf("submit: %w", err)
+	}
+	attempt := client.Attempt{Time: now, Day: day, Part: part, Verdict: response.Verdict, Wait: response.Wait, Answer: answer}
+	if err := history.Record(attempt); err != nil {
+		return fmt.Errorf("submit: %w", err)
+	}
+
+	fmt.Println(response.Message)
+	if response.Verdict != client.Right {
+		return fmt.Errorf("submit: the answer was not accepted: %s", response.Verdict)
+	}
+	return nil
+}
+
+// writeReport implements the report command.
+func writeReport(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("report", flag.ContinueOnError)
+	year := fs.Int("year", setup.DefaultYear(), "Year of the puzzles")
+	dataDir := fs.String("data", "", "Root `directory` of the puzzle data (default $AOC_DATA_DIR or data)")
+	readme := fs.String("readme", "README.md", "Path to the `file` with the marked tables to update")
+	timeout := fs.Duration("timeout", 0, "Stop a run of a day that takes longer than `duration` (default no limit)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("report: unexpected arguments %v", fs.Args())
+	}
+
+	doc, err := os.ReadFile(*readme)
+	if err != nil {
+		return fmt.Errorf("report: %w", err)
+	}
+	days, err := report.Collect(ctx, *year, setup.Params{DataDir: *dataDir, Timeout: *timeout})
+	if err != nil {
+		return fmt.Errorf("report: %w", err)
+	}
+	updated, err := report.Update(string(doc), *year, days)
+	if err != nil {
+		return fmt.Errorf("report: %s: %w", *readme, err)
+	}
+	if updated == string(doc) {
+		fmt.Println(*readme, "is up to date")
+		return nil
+	}
+	if err := os.WriteFile(*readme, []byte(updated), 0o644); err != nil {
+		return fmt.Errorf("report: %w", err)
+	}
+	fmt.Println("updated", *readme)
+	return nil
+}
+
+// watchDay implements the watch command. The day is run by "go run" in the module so that changes to its source files
+// are built. The arguments after -- are passed to the run command.
+func watchDay(ctx context.Context, args []string) error {
+	if len(args) < 1 {
+		return errors.New("watch: missing day")
+	}
+	day, err := strconv.Atoi(args[0])
+	if err != nil || day < 1 {
+		return fmt.Errorf("watch: invalid day %q", args[0])
+	}
+
+	fs := flag.NewFlagSet("watch", flag.ContinueOnError)
+	year := fs.Int("year", setup.DefaultYear(), "Year of the puzzles")
+	dataDir := fs.String("data", "", "Root `directory` of the puzzle data (default $AOC_DATA_DIR or data)")
+	root := fs.String("root", ".", "Root `directory` of the module")
+	interval := fs.Duration("interval", 500*time.Millisecond, "Time between checks for changes")
+	if err := fs.Parse(args[1:]); err != nil {
+		return err
+	}
+	if *dataDir == "" {
+		*dataDir = setup.DataDir()
+	}
+	if *interval <= 0 {
+		return errors.New("watch: invalid interval, must be positive")
+	}
+	// The day is run in the root of the module, so the data directory must not be relative to this one.
+	data, err := filepath.Abs(*dataDir)
+	if err != nil {
+		return fmt.Errorf("watch: %w", err)
+	}
+
+	runArgs := []string{"run", "./cmd/aoc", "run", strconv.Itoa(day), "-year", strconv.Itoa(*year), "-data", data,
+		"-part", "all", "-output", setup.OutputJSON}
+	runArgs = append(runArgs, fs.Args()...)
+	w := &watch.Watcher{
+		Title: fmt.Sprintf("%d Day %d", *year, day),
+		Dirs: []string{
+			filepath.Join(*root, "internal", "days", strconv.Itoa(*year), fmt.Sprintf("day%02d", day)),
+			setup.DayDir(data, *year, day),
+		},
+		Interval: *interval,
+		Out:      os.Stdout,
+		Run: func(ctx context.Context) ([]setup.Result, error) {
+			cmd := exec.CommandContext(ctx, "go", runArgs...)
+			cmd.Dir = *root
+			cmd.Stderr = os.Stderr
+			out, err := cmd.Output()
+			// A run with a part that fails exits with an error, but its results are still shown.
+			results, jsonErr := setup.ReadJSON(bytes.NewReader(out))
+			if len(results) > 0 {
+				return results, nil
+			}
+			return nil, errors.Join(err, jsonErr)
+		},
+	}
+	return w.Watch(ctx)
+}
+
+// serve implements the serve command. It returns when ctx is done, after the requests in progress have been answered.
+func serve(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	year := fs.Int("year", setup.DefaultYear(), "Year of the puzzles when a request does not select one")
+	addr := fs.String("addr", "localhost:8080", "TCP `address` to listen on, such as :8080 for all interfaces")
+	timeout := fs.Duration("timeout", 30*time.Second, "Stop solving a request that takes longer than `duration` (0 for no limit)")
+	maxSolves := fs.Int("max-solves", server.DefaultMaxSolves, "Answer 503 to a request while `n` parts are being solved (0 for no limit)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("serve: unexpected arguments %v", fs.Args())
+	}
+	if *timeout < 0 {
+		return errors.New("serve: invalid timeout, must not be negative")
+	}
+	if *maxSolves < 0 {
+		return errors.New("serve: invalid max-solves, must not be negative")
+	}
+
+	s := server.New(*year, *timeout)
+	s.MaxSolves = *maxSolves
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	errs := make(chan error, 1)
+	go func() { errs <- srv.ListenAndServe() }()
+	fmt.Println("serving on", *addr)
+
+	select {
+	case err := <-errs:
+		return fmt.Errorf("serve: %w", err)
+	case <-ctx.Done():
+	}
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("serve: %w", err)
+	}
+	return nil
+}
+
+// parseTarget returns the day selected by target, which is either a day number, or "all" for day 0.
+func parseTarget(target string) (int, error) {
+	if target == "all" {
+		return 0, nil
+	}
+	day, err := strconv.Atoi(target)
+	if err != nil || day < 1 {
+		return 0, fmt.Errorf("invalid day %q", target)
+	}
+	return day, nil
+}
+
+// targetDays returns the days to run for the day returned by parseTarget: the day itself, or every registered day of
+// the year for day 0.
+func targetDays(year int, day int) []int {
+	if day == 0 {
+		return setup.Days(year)
+	}
+	return []int{day}
+}
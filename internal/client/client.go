@@ -0,0 +1,103 @@
+// Package client downloads puzzle inputs from the Advent of Code website and submits answers to it.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// SessionEnv is the environment variable that holds the session token of the website, which is the value of the
+// session cookie of a logged-in browser.
+const SessionEnv = "AOC_SESSION"
+
+// BaseURLEnv is the environment variable that sets the base URL of the website.
+const BaseURLEnv = "AOC_BASE_URL"
+
+// DefaultBaseURL is the base URL of the website if it is not set otherwise.
+const DefaultBaseURL = "https://adventofcode.com"
+
+// userAgent identifies the client to the website, as its maintainers ask of automated tools.
+const userAgent = "github.com/jambolo/advent-of-code-2015"
+
+// ErrNoSession is returned when a request needs the session token and it is not set.
+var ErrNoSession = errors.New(SessionEnv + " is not set")
+
+// BaseURL returns the base URL of the website set by the AOC_BASE_URL environment variable, or DefaultBaseURL if the
+// variable is not set.
+func BaseURL() string {
+	if url := os.Getenv(BaseURLEnv); url != "" {
+		return url
+	}
+	return DefaultBaseURL
+}
+
+// Client makes requests to the website on behalf of a logged-in user.
+type Client struct {
+	BaseURL string       // Base URL of the website, without a trailing slash
+	Session string       // Session token of the user
+	HTTP    *http.Client // Client used for the requests, or nil for http.DefaultClient
+}
+
+// New returns a client for the website at baseURL with the session token set by the AOC_SESSION environment
+// variable.
+func New(baseURL string) *Client {
+	return &Client{
+		BaseURL: strings.TrimRight(baseURL, "/"),
+		Session: strings.TrimSpace(os.Getenv(SessionEnv)),
+	}
+}
+
+// get returns the body of the page at the given path of the website. A response other than 200 OK is an error.
+func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
+	req, err := c.request(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(req)
+}
+
+// request returns a new request for the given path of the website with the session cookie of the user.
+func (c *Client) request(ctx context.Context, method string, path string, body io.Reader) (*http.Request, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.BaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	req.Header.Set("User-Agent", userAgent)
+	return req, nil
+}
+
+// do sends the request and returns the body of the response. A response other than 200 OK is an error that includes
+// the first line of the body, which usually explains it.
+func (c *Client) do(req *http.Request) ([]byte, error) {
+	httpClient := c.HTTP
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		reason, _, _ := strings.Cut(strings.TrimSpace(string(body)), "\n")
+		if reason != "" {
+			return nil, fmt.Errorf("%s %s: %s: %s", req.Method, req.URL, resp.Status, reason)
+		}
+		return nil, fmt.Errorf("%s %s: %s", req.Method, req.URL, resp.Status)
+	}
+	return body, nil
+}
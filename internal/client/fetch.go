@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Fetch downloads the puzzle input of the given day of the given year to the file at path, unless the file is already
+// cached there. An empty file is not a cached input and is replaced. Fetch returns true if the input was downloaded.
+func (c *Client) Fetch(ctx context.Context, year int, day int, path string) (bool, error) {
+	if cached(path) {
+		return false, nil
+	}
+
+	input, err := c.get(ctx, fmt.Sprintf("/%d/day/%d/input", year, day))
+	if err != nil {
+		return false, err
+	}
+	if len(input) == 0 {
+		return false, fmt.Errorf("the input of %d day %d is empty", year, day)
+	}
+	if err := writeFile(path, input); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// cached returns true if the file at path exists and is not empty.
+func cached(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Size() > 0
+}
+
+// writeFile writes data to the file at path, creating its directory if needed. The data is written to a temporary
+// file that replaces the file at path only once it is complete, so an interrupted download does not leave a partial
+// input in the cache.
+func writeFile(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(f.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
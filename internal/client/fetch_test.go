@@ -0,0 +1,142 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newServer starts a stand-in for the website that serves the given input for every day and counts the requests.
+func newServer(t *testing.T, input string, requests *int) *Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "token" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		if r.Method != http.MethodGet || r.URL.Path != "/2015/day/7/input" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(input))
+	}))
+	t.Cleanup(server.Close)
+	return &Client{BaseURL: server.URL, Session: "token", HTTP: server.Client()}
+}
+
+func TestFetch(t *testing.T) {
+	var requests int
+	c := newServer(t, "123 -> x\n", &requests)
+	path := filepath.Join(t.TempDir(), "2015", "day07", "day07-input.txt")
+
+	fetched, err := c.Fetch(t.Context(), 2015, 7, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !fetched {
+		t.Error("expected the input to be fetched")
+	}
+	if content, _ := os.ReadFile(path); string(content) != "123 -> x\n" {
+		t.Errorf("unexpected input %q", content)
+	}
+
+	// A cached input is never fetched again.
+	fetched, err = c.Fetch(t.Context(), 2015, 7, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fetched || requests != 1 {
+		t.Errorf("expected the cached input to be used, got %d requests", requests)
+	}
+}
+
+func TestFetch_ReplacesEmptyFile(t *testing.T) {
+	var requests int
+	c := newServer(t, "123 -> x\n", &requests)
+	path := filepath.Join(t.TempDir(), "day07-input.txt")
+	if err := os.WriteFile(path, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if fetched, err := c.Fetch(t.Context(), 2015, 7, path); err != nil || !fetched {
+		t.Fatalf("expected the empty file to be replaced, got %v, %v", fetched, err)
+	}
+	if content, _ := os.ReadFile(path); string(content) != "123 -> x\n" {
+		t.Errorf("unexpected input %q", content)
+	}
+}
+
+func TestFetch_Errors(t *testing.T) {
+	var requests int
+	c := newServer(t, "123 -> x\n", &requests)
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		client  Client
+		day     int
+		message string
+	}{
+		{"unknown day", *c, 8, "404 Not Found"},
+		{"wrong session", Client{BaseURL: c.BaseURL, Session: "expired", HTTP: c.HTTP}, 7, "Please log in"},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(dir, tt.name+".txt")
+		_, err := tt.client.Fetch(t.Context(), 2015, tt.day, path)
+		if err == nil || !strings.Contains(err.Error(), tt.message) {
+			t.Errorf("%s: expected error containing %q, got %v", tt.name, tt.message, err)
+		}
+		if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("%s: expected no file to be written", tt.name)
+		}
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("expected no temporary files to be left, got %v", entries)
+	}
+}
+
+func TestFetch_NoSession(t *testing.T) {
+	var requests int
+	c := newServer(t, "123 -> x\n", &requests)
+	c.Session = ""
+	path := filepath.Join(t.TempDir(), "day07-input.txt")
+
+	if _, err := c.Fetch(t.Context(), 2015, 7, path); !errors.Is(err, ErrNoSession) {
+		t.Errorf("expected ErrNoSession, got %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("expected no requests, got %d", requests)
+	}
+
+	// A cached input does not need a session.
+	if err := os.WriteFile(path, []byte("123 -> x\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if fetched, err := c.Fetch(t.Context(), 2015, 7, path); err != nil || fetched {
+		t.Errorf("expected the cached input to be used, got %v, %v", fetched, err)
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Setenv(SessionEnv, " token\n")
+	c := New("http://localhost:8080/")
+	if c.BaseURL != "http://localhost:8080" || c.Session != "token" {
+		t.Errorf("unexpected client %+v", c)
+	}
+}
+
+func TestBaseURL(t *testing.T) {
+	t.Setenv(BaseURLEnv, "")
+	if url := BaseURL(); url != DefaultBaseURL {
+		t.Errorf("expected %s, got %s", DefaultBaseURL, url)
+	}
+	t.Setenv(BaseURLEnv, "http://localhost:8080")
+	if url := BaseURL(); url != "http://localhost:8080" {
+		t.Errorf("expected http://localhost:8080, got %s", url)
+	}
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+)
+
+// Errors returned by History.Check
+var (
+	ErrSolved     = errors.New("the part is already solved")
+	ErrKnownWrong = errors.New("the answer is known to be wrong")
+	ErrThrottled  = errors.New("the last answer was submitted too recently")
+)
+
+// historyFormat is the format of each line of a history file, which is written in its first line.
+const historyFormat = "time day part verdict wait answer"
+
+// HistoryPath returns the default path of the submission history of the given year in the data directory dir.
+func HistoryPath(dir string, year int) string {
+	return filepath.Join(dir, strconv.Itoa(year), "submissions.txt")
+}
+
+// Attempt is one submission of an answer.
+type Attempt struct {
+	Time    time.Time
+	Day     int
+	Part    int
+	Verdict Verdict
+	Wait    time.Duration // Time the website asked to wait before the next submission
+	Answer  string
+}
+
+// History is the record of every answer submitted for the puzzles of one year.
+type History struct {
+	path     string
+	Attempts []Attempt
+}
+
+// LoadHistory reads the history in the file at path. Each line has the form "time day part verdict wait answer".
+// Blank lines and lines starting with # are ignored. A missing file is an empty history.
+func LoadHistory(path string) (*History, error) {
+	h := &History{path: path}
+	lines, err := load.Numbered(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range lines {
+		text := strings.TrimSpace(line.Text)
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		a, err := parseAttempt(line)
+		if err != nil {
+			return nil, err
+		}
+		h.Attempts = append(h.Attempts, a)
+	}
+	return h, nil
+}
+
+// parseAttempt parses one line of a history file, or returns a ParseError at the field that is invalid.
+func parseAttempt(line load.Line) (Attempt, error) {
+	text := strings.TrimSpace(line.Text)
+	start := strings.Index(line.Text, text) + 1 // Column of the first field
+	fields := strings.SplitN(text, " ", 6)
+	if len(fields) != 6 {
+		return Attempt{}, line.Errorf(start, text, "expected %q", historyFormat)
+	}
+	columns := make([]int, len(fields))
+	columns[0] = start
+	for i := 1; i < len(fields); i++ {
+		columns[i] = columns[i-1] + len(fields[i-1]) + 1
+	}
+
+	var a Attempt
+	var err error
+	if a.Time, err = time.Parse(time.RFC3339, fields[0]); err != nil {
+		return Attempt{}, line.Errorf(columns[0], fields[0], "invalid time")
+	}
+	if a.Day, err = strconv.Atoi(fields[1]); err != nil {
+		return Attempt{}, line.Errorf(columns[1], fields[1], "invalid day")
+	}
+	if a.Part, err = strconv.Atoi(fields[2]); err != nil {
+		return Attempt{}, line.Errorf(columns[2], fields[2], "invalid part")
+	}
+	a.Verdict = Verdict(fields[3])
+	if a.Wait, err = time.ParseDuration(fields[4]); err != nil {
+		return Attempt{}, line.Errorf(columns[4], fields[4], "invalid wait")
+	}
+	a.Answer = fields[5]
+	return a, nil
+}
+
+// Check returns an error if the answer to the given part of the given day should not be submitted at time now: the
+// part is already solved, the answer is known to be wrong, or the website asked to wait after the last attempt. An
+// integer answer is also known to be wrong if it is not between the answers that were too low and too high.
+func (h *History) Check(day int, part int, answer string, now time.Time) error {
+	var last *Attempt
+	for i := range h.Attempts {
+		a := &h.Attempts[i]
+		if a.Day != day || a.Part != part {
+			continue
+		}
+		last = a
+		switch {
+		case a.Verdict == Right:
+			return fmt.Errorf("%w with %s", ErrSolved, a.Answer)
+		case a.Verdict == AlreadySolved:
+			return ErrSolved
+		case a.Verdict.IsWrong() && a.Answer == answer:
+			return fmt.Errorf("%w: %s was %s on %s", ErrKnownWrong, answer, a.Verdict, a.Time.Format(time.DateTime))
+		case a.Verdict == TooHigh && atLeast(answer, a.Answer):
+			return fmt.Errorf("%w: %s is not lower than %s, which is too high", ErrKnownWrong, answer, a.Answer)
+		case a.Verdict == TooLow && atLeast(a.Answer, answer):
+			return fmt.Errorf("%w: %s is not higher than %s, which is too low", ErrKnownWrong, answer, a.Answer)
+		}
+	}
+	if last != nil {
+		if left := last.Time.Add(last.Wait).Sub(now); left > 0 {
+			return fmt.Errorf("%w, %v left to wait", ErrThrottled, left.Round(time.Second))
+		}
+	}
+	return nil
+}
+
+// atLeast returns true if both answers are integers and a is greater than or equal to b.
+func atLeast(a string, b string) bool {
+	x, okA := new(big.Int).SetString(a, 10)
+	y, okB := new(big.Int).SetString(b, 10)
+	return okA && okB && x.Cmp(y) >= 0
+}
+
+// Record adds the attempt to the history and appends it to the history file, which is created if needed.
+func (h *History) Record(a Attempt) error {
+	if err := os.MkdirAll(filepath.Dir(h.path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(h.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	if info, err := f.Stat(); err == nil && info.Size() == 0 {
+		if _, err := f.WriteString("# " + historyFormat + "\n"); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	line := fmt.Sprintf("%s %d %d %s %v %s\n", a.Time.UTC().Format(time.RFC3339), a.Day, a.Part, a.Verdict, a.Wait, a.Answer)
+	if _, err := f.WriteString(line); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	h.Attempts = append(h.Attempts, a)
+	return nil
+}
@@ -0,0 +1,113 @@
+package client
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+)
+
+func TestHistory_RecordAndLoad(t *testing.T) {
+	path := HistoryPath(t.TempDir(), 2015)
+	h, err := LoadHistory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h.Attempts) != 0 {
+		t.Fatalf("expected an empty history, got %v", h.Attempts)
+	}
+
+	now := time.Date(2015, 12, 7, 5, 0, 0, 0, time.UTC)
+	attempts := []Attempt{
+		{Time: now, Day: 7, Part: 1, Verdict: TooLow, Wait: time.Minute, Answer: "123"},
+		{Time: now.Add(2 * time.Minute), Day: 7, Part: 1, Verdict: Right, Answer: "46065"},
+	}
+	for _, a := range attempts {
+		if err := h.Record(a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "# time day part verdict wait answer\n" +
+		"2015-12-07T05:00:00Z 7 1 too-low 1m0s 123\n" +
+		"2015-12-07T05:02:00Z 7 1 right 0s 46065\n"
+	if string(content) != expected {
+		t.Errorf("expected %q, got %q", expected, content)
+	}
+
+	loaded, err := LoadHistory(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.Attempts) != 2 || loaded.Attempts[0] != attempts[0] || loaded.Attempts[1] != attempts[1] {
+		t.Errorf("expected %v, got %v", attempts, loaded.Attempts)
+	}
+}
+
+func TestLoadHistory_Invalid(t *testing.T) {
+	tests := []struct {
+		content string
+		column  int
+	}{
+		{"2015-12-07T05:00:00Z 7 1 right 0s\n", 1},
+		{"yesterday 7 1 right 0s 46065\n", 1},
+		{"2015-12-07T05:00:00Z x 1 right 0s 46065\n", 22},
+		{"2015-12-07T05:00:00Z 7 1 right soon 46065\n", 32},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), "submissions.txt")
+		if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		_, err := LoadHistory(path)
+		var pe *load.ParseError
+		if !errors.As(err, &pe) || pe.Line != 1 || pe.Column != tt.column {
+			t.Errorf("%q: expected an error at 1:%d, got %v", tt.content, tt.column, err)
+		}
+	}
+}
+
+func TestHistory_Check(t *testing.T) {
+	start := time.Date(2015, 12, 7, 5, 0, 0, 0, time.UTC)
+	h := &History{Attempts: []Attempt{
+		{Time: start, Day: 7, Part: 1, Verdict: TooLow, Wait: time.Minute, Answer: "100"},
+		{Time: start.Add(time.Minute), Day: 7, Part: 1, Verdict: TooHigh, Wait: 5 * time.Minute, Answer: "200"},
+		{Time: start, Day: 7, Part: 2, Verdict: Right, Answer: "150"},
+		{Time: start, Day: 8, Part: 1, Verdict: Wrong, Wait: time.Minute, Answer: "abc"},
+		{Time: start, Day: 9, Part: 1, Verdict: RateLimited, Wait: 30 * time.Second, Answer: "141"},
+	}}
+	later := start.Add(time.Hour)
+
+	tests := []struct {
+		day, part int
+		answer    string
+		now       time.Time
+		expected  error
+	}{
+		{7, 1, "150", later, nil},
+		{7, 1, "100", later, ErrKnownWrong},
+		{7, 1, "99", later, ErrKnownWrong},
+		{7, 1, "200", later, ErrKnownWrong},
+		{7, 1, "1000", later, ErrKnownWrong},
+		{7, 1, "150", start.Add(3 * time.Minute), ErrThrottled},
+		{7, 2, "150", later, ErrSolved},
+		{8, 1, "abc", later, ErrKnownWrong},
+		{8, 1, "abd", later, nil},
+		{9, 1, "141", start.Add(10 * time.Second), ErrThrottled},
+		{9, 1, "141", start.Add(time.Minute), nil},
+		{10, 1, "1", start, nil},
+	}
+	for _, tt := range tests {
+		err := h.Check(tt.day, tt.part, tt.answer, tt.now)
+		if tt.expected == nil && err != nil || !errors.Is(err, tt.expected) {
+			t.Errorf("day %d part %d, %s: expected %v, got %v", tt.day, tt.part, tt.answer, tt.expected, err)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict is the judgement of the website on a submitted answer.
+type Verdict string
+
+// Verdicts of a submission
+const (
+	Right         Verdict = "right"          // The answer is right.
+	Wrong         Verdict = "wrong"          // The answer is wrong.
+	TooHigh       Verdict = "too-high"       // The answer is wrong and too high.
+	TooLow        Verdict = "too-low"        // The answer is wrong and too low.
+	RateLimited   Verdict = "rate-limited"   // The answer was not checked because the last one was submitted too recently.
+	AlreadySolved Verdict = "already-solved" // The answer was not checked because the part is already solved.
+)
+
+// IsWrong returns true if the verdict is that the answer is wrong.
+func (v Verdict) IsWrong() bool {
+	return v == Wrong || v == TooHigh || v == TooLow
+}
+
+// Response is the response of the website to a submitted answer.
+type Response struct {
+	Verdict Verdict
+	Wait    time.Duration // Time to wait before the next submission, if the website says so
+	Message string        // Text of the response, without markup
+}
+
+var (
+	articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagPattern     = regexp.MustCompile(`<[^>]*>`)
+	leftPattern    = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	minutesPattern = regexp.MustCompile(`wait (one|\d+) minutes? before trying again`)
+)
+
+// Submit submits the answer to the given part of the given day of the given year and returns the response of the
+// website.
+func (c *Client) Submit(ctx context.Context, year int, day int, part int, answer string) (Response, error) {
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	path := fmt.Sprintf("/%d/day/%d/answer", year, day)
+	req, err := c.request(ctx, http.MethodPost, path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Response{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	body, err := c.do(req)
+	if err != nil {
+		return Response{}, err
+	}
+	return ParseResponse(body)
+}
+
+// ParseResponse parses the page returned by the website for a submitted answer. The verdict is found in the text of
+// the article of the page.
+func ParseResponse(page []byte) (Response, error) {
+	text := string(page)
+	if m := articlePattern.FindStringSubmatch(text); m != nil {
+		text = m[1]
+	}
+	text = html.UnescapeString(tagPattern.ReplaceAllString(text, ""))
+	r := Response{Message: strings.Join(strings.Fields(text), " ")}
+
+	switch {
+	case strings.Contains(r.Message, "That's the right answer"):
+		r.Verdict = Right
+	case strings.Contains(r.Message, "answer is too high"):
+		r.Verdict = TooHigh
+	case strings.Contains(r.Message, "answer is too low"):
+		r.Verdict = TooLow
+	case strings.Contains(r.Message, "That's not the right answer"):
+		r.Verdict = Wrong
+	case strings.Contains(r.Message, "You gave an answer too recently"):
+		r.Verdict = RateLimited
+	case strings.Contains(r.Message, "You don't seem to be solving the right level"):
+		r.Verdict = AlreadySolved
+	default:
+		return Response{}, fmt.Errorf("unexpected response: %q", r.Message)
+	}
+
+	if m := leftPattern.FindStringSubmatch(r.Message); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		r.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if m := minutesPattern.FindStringSubmatch(r.Message); m != nil {
+		minutes := 1
+		if m[1] != "one" {
+			minutes, _ = strconv.Atoi(m[1])
+		}
+		r.Wait = time.Duration(minutes) * time.Minute
+	}
+	return r, nil
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// Responses of the website, trimmed to their articles
+const (
+	rightPage = `<main><article><p>That's the right answer!  You are <span class="day-success">one gold star</span>
+closer to powering the weather machine. <a href="/2015/day/7#part2">[Continue to Part Two]</a></p></article></main>`
+	tooHighPage = `<main><article><p>That's not the right answer; your answer is too high.  If you're stuck, make sure
+you're using the full input data; there are also some general tips on the <a href="/2015/about">about page</a>, or you
+can ask for hints on the <a href="https://www.reddit.com/r/adventofcode/" target="_blank">subreddit</a>.  Please wait
+one minute before trying again. <a href="/2015/day/7">[Return to Day 7]</a></p></article></main>`
+	tooLowPage = `<main><article><p>That's not the right answer; your answer is too low.  Please wait 5 minutes
+before trying again. <a href="/2015/day/7">[Return to Day 7]</a></p></article></main>`
+	wrongPage = `<main><article><p>That's not the right answer.  If you're stuck, make sure you're using the full
+input data.  Please wait one minute before trying again.</p></article></main>`
+	rateLimitedPage = `<main><article><p>You gave an answer too recently; you have to wait after submitting an answer
+before trying again.  You have 4m 38s left to wait. <a href="/2015/day/7">[Return to Day 7]</a></p></article></main>`
+	shortWaitPage = `<main><article><p>You gave an answer too recently; you have to wait after submitting an answer
+before trying again.  You have 38s left to wait.</p></article></main>`
+	solvedPage = `<main><article><p>You don't seem to be solving the right level.  Did you already complete it?
+<a href="/2015/day/7">[Return to Day 7]</a></p></article></main>`
+)
+
+func TestParseResponse(t *testing.T) {
+	tests := []struct {
+		page    string
+		verdict Verdict
+		wait    time.Duration
+	}{
+		{rightPage, Right, 0},
+		{tooHighPage, TooHigh, time.Minute},
+		{tooLowPage, TooLow, 5 * time.Minute},
+		{wrongPage, Wrong, time.Minute},
+		{rateLimitedPage, RateLimited, 4*time.Minute + 38*time.Second},
+		{shortWaitPage, RateLimited, 38 * time.Second},
+		{solvedPage, AlreadySolved, 0},
+	}
+	for _, tt := range tests {
+		r, err := ParseResponse([]byte(tt.page))
+		if err != nil {
+			t.Errorf("%s: %v", tt.verdict, err)
+			continue
+		}
+		if r.Verdict != tt.verdict || r.Wait != tt.wait {
+			t.Errorf("%s: expected verdict %s and wait %v, got %s and %v", tt.verdict, tt.verdict, tt.wait, r.Verdict, r.Wait)
+		}
+	}
+
+	r, _ := ParseResponse([]byte(rightPage))
+	expected := "That's the right answer! You are one gold star closer to powering the weather machine. [Continue to Part Two]"
+	if r.Message != expected {
+		t.Errorf("expected message %q, got %q", expected, r.Message)
+	}
+}
+
+func TestParseResponse_Unexpected(t *testing.T) {
+	if _, err := ParseResponse([]byte("<main><article><p>Something else.</p></article></main>")); err == nil {
+		t.Error("expected error for an unexpected response")
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/2015/day/7/answer" {
+			http.NotFound(w, r)
+			return
+		}
+		if cookie, err := r.Cookie("session"); err != nil || cookie.Value != "token" {
+			http.Error(w, "Unauthorized", http.StatusBadRequest)
+			return
+		}
+		switch {
+		case r.FormValue("level") != "2":
+			w.Write([]byte(solvedPage))
+		case r.FormValue("answer") == "14134":
+			w.Write([]byte(rightPage))
+		default:
+			w.Write([]byte(tooHighPage))
+		}
+	}))
+	defer server.Close()
+	c := &Client{BaseURL: server.URL, Session: "token", HTTP: server.Client()}
+
+	tests := []struct {
+		part    int
+		answer  string
+		verdict Verdict
+	}{
+		{2, "14134", Right},
+		{2, "99999", TooHigh},
+		{1, "46065", AlreadySolved},
+	}
+	for _, tt := range tests {
+		r, err := c.Submit(t.Context(), 2015, 7, tt.part, tt.answer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Verdict != tt.verdict {
+			t.Errorf("part %d, %s: expected %s, got %s", tt.part, tt.answer, tt.verdict, r.Verdict)
+		}
+	}
+
+	if _, err := c.Submit(t.Context(), 2015, 8, 1, "12"); err == nil {
+		t.Error("expected error for a page that does not exist")
+	}
+}
@@ -0,0 +1,55 @@
+package day01
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 1, func() setup.Solver { return &solver{} })
+}
+
+type solver struct {
+	input string
+}
+
+// Parse loads the instructions.
+func (s *solver) Parse(path string) (err error) {
+	s.input, err = load.All(path)
+	return err
+}
+
+// Part1 returns the floor that the instructions end on.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	// Count the difference between '(' and ')'
+	floor := 0
+	for _, char := range s.input {
+		switch char {
+		case '(':
+			floor++
+		case ')':
+			floor--
+		}
+	}
+	return floor, nil
+}
+
+// Part2 returns the position of the first character that causes the floor to go below 0.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	floor := 0
+	for position, char := range s.input {
+		switch char {
+		case '(':
+			floor++
+		case ')':
+			floor--
+		}
+		if floor < 0 {
+			return position + 1, nil
+		}
+	}
+	return nil, errors.New("the basement is never entered")
+}
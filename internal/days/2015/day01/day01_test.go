@@ -0,0 +1,49 @@
+package day01
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestPart1_Examples(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"(())", 0},
+		{"()()", 0},
+		{"(((", 3},
+		{"))(((((", 3},
+		{"())", -1},
+		{")))", -3},
+		{")())())", -3},
+	}
+	for _, tt := range tests {
+		if got := setuptest.Solve(t, &solver{}, tt.input, 1); got != tt.expected {
+			t.Errorf("%s: expected %d, got %v", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestPart2_Examples(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{")", 1},
+		{"()())", 5},
+	}
+	for _, tt := range tests {
+		if got := setuptest.Solve(t, &solver{}, tt.input, 2); got != tt.expected {
+			t.Errorf("%s: expected %d, got %v", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestPart2_NeverInBasement(t *testing.T) {
+	s := &solver{input: "(()"}
+	if _, err := s.Part2(t.Context()); err == nil {
+		t.Fatal("expected error when the basement is never entered")
+	}
+}
@@ -0,0 +1,56 @@
+package day02
+
+import (
+	"context"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 2, func() setup.Solver { return &solver{} })
+}
+
+type box struct {
+	Length int
+	Width  int
+	Height int
+}
+
+var boxPattern = load.MustCompile[box]("{Length:int}x{Width:int}x{Height:int}")
+
+type solver struct {
+	boxes []box
+}
+
+// Parse parses the dimensions of each box.
+func (s *solver) Parse(path string) error {
+	var err error
+	s.boxes, err = boxPattern.Lines(path)
+	return err
+}
+
+// Part1 returns the total area of wrapping paper needed.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	total := 0
+	for _, b := range s.boxes {
+		// Calculate the surface area of the box and add the area of the smallest side as extra
+		lw := b.Length * b.Width
+		wh := b.Width * b.Height
+		hl := b.Height * b.Length
+		total += 2*(lw+wh+hl) + min(lw, wh, hl)
+	}
+	return total, nil
+}
+
+// Part2 returns the total length of ribbon needed.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	total := 0
+	for _, b := range s.boxes {
+		// Smallest perimeter + volume for the bow
+		perimeter := 2 * min(b.Length+b.Width, b.Width+b.Height, b.Height+b.Length)
+		volume := b.Length * b.Width * b.Height
+		total += perimeter + volume
+	}
+	return total, nil
+}
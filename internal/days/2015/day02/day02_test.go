@@ -0,0 +1,46 @@
+package day02
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestPart1_Examples(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"2x3x4", 58},
+		{"1x1x10", 43},
+		{"2x3x4\n1x1x10\n", 101},
+	}
+	for _, tt := range tests {
+		if got := setuptest.Solve(t, &solver{}, tt.input, 1); got != tt.expected {
+			t.Errorf("%q: expected %d, got %v", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestPart2_Examples(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"2x3x4", 34},
+		{"1x1x10", 14},
+		{"2x3x4\n1x1x10\n", 48},
+	}
+	for _, tt := range tests {
+		if got := setuptest.Solve(t, &solver{}, tt.input, 2); got != tt.expected {
+			t.Errorf("%q: expected %d, got %v", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestParse_InvalidBox(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(setuptest.Input(t, "2x3\n")); err == nil {
+		t.Fatal("expected error for invalid box")
+	}
+}
@@ -0,0 +1,73 @@
+package day03
+
+import (
+	"context"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 3, func() setup.Solver { return &solver{} })
+}
+
+type point struct{ x, y int }
+type pointSet map[point]struct{}
+
+// move updates x and y based on the direction character and returns the new coordinates
+func move(x, y int, char rune) (int, int) {
+	switch char {
+	case '^':
+		y++
+	case 'v':
+		y--
+	case '>':
+		x++
+	case '<':
+		x--
+	}
+	return x, y
+}
+
+type solver struct {
+	input string
+}
+
+// Parse loads the directions.
+func (s *solver) Parse(path string) (err error) {
+	s.input, err = load.All(path)
+	return err
+}
+
+// Part1 returns the number of houses visited by Santa.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	x := 0
+	y := 0
+	visited := pointSet{{0, 0}: {}}
+
+	for _, char := range s.input {
+		x, y = move(x, y, char)
+		visited[point{x, y}] = struct{}{}
+	}
+	return len(visited), nil
+}
+
+// Part2 returns the number of houses visited by Santa and Robo-Santa taking turns.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	santaX := 0
+	santaY := 0
+	roboX := 0
+	roboY := 0
+	visited := pointSet{{0, 0}: {}}
+
+	for i, char := range s.input {
+		if i%2 == 0 {
+			santaX, santaY = move(santaX, santaY, char)
+			visited[point{santaX, santaY}] = struct{}{}
+		} else {
+			roboX, roboY = move(roboX, roboY, char)
+			visited[point{roboX, roboY}] = struct{}{}
+		}
+	}
+	return len(visited), nil
+}
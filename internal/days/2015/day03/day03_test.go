@@ -0,0 +1,39 @@
+package day03
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestPart1_Examples(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{">", 2},
+		{"^>v<", 4},
+		{"^v^v^v^v^v", 2},
+	}
+	for _, tt := range tests {
+		if got := setuptest.Solve(t, &solver{}, tt.input, 1); got != tt.expected {
+			t.Errorf("%s: expected %d, got %v", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestPart2_Examples(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"^v", 3},
+		{"^>v<", 3},
+		{"^v^v^v^v^v", 11},
+	}
+	for _, tt := range tests {
+		if got := setuptest.Solve(t, &solver{}, tt.input, 2); got != tt.expected {
+			t.Errorf("%s: expected %d, got %v", tt.input, tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package day04
+
+import (
+	"context"
+	"crypto/md5"
+	"errors"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 4, func() setup.Solver { return &solver{} })
+}
+
+type solver struct {
+	prefix string
+}
+
+// Parse loads the secret key.
+func (s *solver) Parse(path string) error {
+	prefix, err := load.All(path)
+	if err != nil {
+		return err
+	}
+	s.prefix = strings.TrimSpace(prefix)
+	return nil
+}
+
+// Flags defines a flag that overrides the secret key.
+func (s *solver) Flags(fs *flag.FlagSet) {
+	fs.StringVar(&s.prefix, "prefix", s.prefix, "Secret key (overrides the input)")
+}
+
+// Validate checks that the secret key is not empty.
+func (s *solver) Validate() error {
+	if s.prefix == "" {
+		return errors.New("the secret key is empty")
+	}
+	return nil
+}
+
+// Part1 returns the lowest number that produces a hash starting with five zeroes.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	budget := setup.NewBudget(ctx)
+	for i := 0; ; i++ {
+		if err := budget.Step(); err != nil {
+			return nil, err
+		}
+		input := fmt.Sprintf("%s%d", s.prefix, i)
+		hash := md5.Sum([]byte(input))
+		// Each byte is two hex characters, so we check the first three bytes for 5 leading zeroes (00000)
+		if hash[0] == 0 && hash[1] == 0 && hash[2] < 16 {
+			return i, nil
+		}
+	}
+}
+
+// Part2 returns the lowest number that produces a hash starting with six zeroes.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	budget := setup.NewBudget(ctx)
+	for i := 0; ; i++ {
+		if err := budget.Step(); err != nil {
+			return nil, err
+		}
+		input := fmt.Sprintf("%s%d", s.prefix, i)
+		hash := md5.Sum([]byte(input))
+		// Each byte is two hex characters, so we check the first three bytes for 6 leading zeroes (000000)
+		if hash[0] == 0 && hash[1] == 0 && hash[2] == 0 {
+			return i, nil
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package day04
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestPart1_Examples(t *testing.T) {
+	tests := []struct {
+		prefix   string
+		expected int
+	}{
+		{"abcdef", 609043},
+		{"pqrstuv", 1048970},
+	}
+	for _, tt := range tests {
+		s := &solver{prefix: tt.prefix}
+		got, err := s.Part1(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.expected {
+			t.Errorf("%s: expected %d, got %v", tt.prefix, tt.expected, got)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(setuptest.Input(t, "abcdef\n")); err != nil {
+		t.Fatal(err)
+	}
+	if s.prefix != "abcdef" {
+		t.Fatalf("expected abcdef, got %q", s.prefix)
+	}
+	if err := s.Parse(setuptest.Input(t, "\n")); err != nil || s.Validate() == nil {
+		t.Fatalf("expected an empty key to be parsed and then rejected by Validate, got %v", err)
+	}
+}
+
+func TestPart2_Timeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 0)
+	defer cancel()
+	s := &solver{prefix: "abcdef"}
+	if _, err := s.Part2(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+}
@@ -1,14 +1,17 @@
-package main
+package day05
 
 import (
-	"fmt"
-	"log"
+	"context"
 	"strings"
 
 	"github.com/jambolo/advent-of-code-2015/internal/load"
 	"github.com/jambolo/advent-of-code-2015/internal/setup"
 )
 
+func init() {
+	setup.Register(2015, 5, func() setup.Solver { return &solver{} })
+}
+
 // hasThreeVowels returns true if the string has at least three vowels (aeiou), false otherwise
 func hasThreeVowels(s string) bool {
 	count := 0
@@ -72,39 +75,34 @@ func hasSplitPair(s string) bool {
 
 var badWords = []string{"ab", "cd", "pq", "xy"}
 
-func main() {
-	day := 5
-
-	// Grab the command line parameters (file path and part number)
-	filePath, part := setup.Parameters(day)
-
-	// Print a banner showing the current day and if it is part 1 or part 2
-	setup.Banner(day, part)
+type solver struct {
+	lines []string
+}
 
-	// Load the data from the specified file. Abort on error.
-	lines, err := load.Lines(filePath)
-	if err != nil {
-		log.Fatal(err)
-	}
+// Parse loads the strings.
+func (s *solver) Parse(path string) (err error) {
+	s.lines, err = load.Lines(path)
+	return err
+}
 
-	// Part 1
-	if part == 1 {
-		niceCount := 0
-		for _, line := range lines {
-			if hasThreeVowels(line) && hasDoubleLetter(line) && hasNoBadWords(line) {
-				niceCount++
-			}
+// Part1 returns the number of nice strings according to the first set of rules.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	niceCount := 0
+	for _, line := range s.lines {
+		if hasThreeVowels(line) && hasDoubleLetter(line) && hasNoBadWords(line) {
+			niceCount++
 		}
-		fmt.Printf("Nice strings: %d\n", niceCount)
 	}
+	return niceCount, nil
+}
 
-	if part == 2 {
-		niceCount := 0
-		for _, line := range lines {
-			if hasRepeatedPair(line) && hasSplitPair(line) {
-				niceCount++
-			}
+// Part2 returns the number of nice strings according to the second set of rules.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	niceCount := 0
+	for _, line := range s.lines {
+		if hasRepeatedPair(line) && hasSplitPair(line) {
+			niceCount++
 		}
-		fmt.Printf("Nice strings: %d\n", niceCount)
 	}
+	return niceCount, nil
 }
@@ -0,0 +1,30 @@
+package day05
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestPart1_Examples(t *testing.T) {
+	input := "ugknbfddgicrmopn\naaa\njchzalrnumimnmhp\nhaegwjzuvuyypxyu\ndvszwmarrgswjxmb\n"
+	if got := setuptest.Solve(t, &solver{}, input, 1); got != 2 {
+		t.Fatalf("expected 2, got %v", got)
+	}
+}
+
+func TestPart2_Examples(t *testing.T) {
+	input := "qjhvhtzxzqqjkmpb\nxxyxx\nuurcxstgmygtbstg\nieodomkazucvgmuy\n"
+	if got := setuptest.Solve(t, &solver{}, input, 2); got != 2 {
+		t.Fatalf("expected 2, got %v", got)
+	}
+}
+
+func TestHasRepeatedPair_Overlapping(t *testing.T) {
+	if hasRepeatedPair("aaa") {
+		t.Fatal("expected overlapping pair to be rejected")
+	}
+	if !hasRepeatedPair("aaaa") {
+		t.Fatal("expected non-overlapping pair to be accepted")
+	}
+}
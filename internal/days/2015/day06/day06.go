@@ -0,0 +1,130 @@
+package day06
+
+import (
+	"context"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+	"github.com/jambolo/advent-of-code-2015/internal/utils"
+)
+
+func init() {
+	setup.Register(2015, 6, func() setup.Solver { return &solver{} })
+}
+
+const size = 1000
+
+type action int
+
+const (
+	on action = iota
+	off
+	toggle
+)
+
+type rect struct {
+	x1, y1, x2, y2 int
+}
+
+// instruction data structure
+type instruction struct {
+	operation action
+	extents   rect
+}
+
+// line is an instruction as it is written in the input.
+type line struct {
+	Action         string
+	X1, Y1, X2, Y2 int
+}
+
+var linePattern = load.MustCompile[line]("{Action:turn on|turn off|toggle} {X1:int},{Y1:int} through {X2:int},{Y2:int}")
+
+// actions are the operations of the instructions by the action in the input.
+var actions = map[string]action{
+	"turn on":  on,
+	"turn off": off,
+	"toggle":   toggle,
+}
+
+// parseInstructions reads the instructions in the file at path.
+func parseInstructions(path string) ([]instruction, error) {
+	lines, err := linePattern.Lines(path)
+	if err != nil {
+		return nil, err
+	}
+	instructions := make([]instruction, len(lines))
+	for i, l := range lines {
+		instructions[i] = instruction{actions[l.Action], rect{l.X1, l.Y1, l.X2, l.Y2}}
+	}
+	return instructions, nil
+}
+
+type solver struct {
+	instructions []instruction
+}
+
+// Parse parses the instructions.
+func (s *solver) Parse(path string) error {
+	var err error
+	s.instructions, err = parseInstructions(path)
+	return err
+}
+
+// Part1 returns the number of lights that are on after following the instructions.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	// Create a 1000x1000 grid of booleans to represent the lights
+	grid := make([]bool, size*size)
+
+	// Apply each instruction to the grid
+	for _, instr := range s.instructions {
+		for x := instr.extents.x1; x <= instr.extents.x2; x++ {
+			for y := instr.extents.y1; y <= instr.extents.y2; y++ {
+				switch instr.operation {
+				case on:
+					grid[y*size+x] = true
+				case off:
+					grid[y*size+x] = false
+				case toggle:
+					grid[y*size+x] = !grid[y*size+x]
+				}
+			}
+		}
+	}
+
+	// Count the number of lights that are on
+	count := 0
+	for _, on := range grid {
+		if on {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// Part2 returns the total brightness of the lights after following the instructions.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	// Create a 1000x1000 grid of integers to represent the brightness of the lights
+	grid := make([]int, size*size)
+
+	// Apply each instruction to the grid
+	for _, instr := range s.instructions {
+		for x := instr.extents.x1; x <= instr.extents.x2; x++ {
+			for y := instr.extents.y1; y <= instr.extents.y2; y++ {
+				switch instr.operation {
+				case on:
+					grid[y*size+x]++
+				case off:
+					if grid[y*size+x] > 0 {
+						grid[y*size+x]--
+					}
+				case toggle:
+					grid[y*size+x] += 2
+				}
+			}
+		}
+	}
+
+	// Add up the brightness of the lights
+	return utils.SliceSum(grid), nil
+}
@@ -0,0 +1,48 @@
+package day06
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestPart1_Examples(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"turn on 0,0 through 999,999", 1000000},
+		{"toggle 0,0 through 999,0", 1000},
+		{"turn on 0,0 through 999,999\nturn off 499,499 through 500,500", 999996},
+	}
+	for _, tt := range tests {
+		if got := setuptest.Solve(t, &solver{}, tt.input, 1); got != tt.expected {
+			t.Errorf("%q: expected %d, got %v", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestPart2_Examples(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{"turn on 0,0 through 0,0", 1},
+		{"toggle 0,0 through 999,999", 2000000},
+		{"turn off 0,0 through 0,0", 0},
+	}
+	for _, tt := range tests {
+		if got := setuptest.Solve(t, &solver{}, tt.input, 2); got != tt.expected {
+			t.Errorf("%q: expected %d, got %v", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestParse_InvalidInstruction(t *testing.T) {
+	for _, input := range []string{"flip 0,0 through 1,1", "turn on 0,0 to 1,1"} {
+		s := &solver{}
+		if err := s.Parse(setuptest.Input(t, input)); err == nil {
+			t.Errorf("%q: expected error", input)
+		}
+	}
+}
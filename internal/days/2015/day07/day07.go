@@ -1,8 +1,8 @@
-package main
+package day07
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"regexp"
 	"strconv"
 
@@ -10,6 +10,10 @@ import (
 	"github.com/jambolo/advent-of-code-2015/internal/setup"
 )
 
+func init() {
+	setup.Register(2015, 7, func() setup.Solver { return &solver{} })
+}
+
 var (
 	reAnd    = regexp.MustCompile(`^([a-z]+|\d+) AND ([a-z]+|\d+) -> ([a-z]+)$`)
 	reOr     = regexp.MustCompile(`^([a-z]+|\d+) OR ([a-z]+|\d+) -> ([a-z]+)$`)
@@ -55,9 +59,10 @@ func parseGate(op op, left, right string) gate {
 	return gate{op: op, lWire: lWire, rWire: rWire, lValue: lValue, rValue: rValue}
 }
 
-func buildCircuit(lines []string) map[string]gate {
+func buildCircuit(lines []load.Line) (map[string]gate, error) {
 	gates := make(map[string]gate)
-	for _, line := range lines {
+	for _, l := range lines {
+		line := l.Text
 		if m := reAnd.FindStringSubmatch(line); m != nil {
 			gates[m[3]] = parseGate(and, m[1], m[2])
 		} else if m := reOr.FindStringSubmatch(line); m != nil {
@@ -71,28 +76,34 @@ func buildCircuit(lines []string) map[string]gate {
 		} else if m := reRshift.FindStringSubmatch(line); m != nil {
 			gates[m[3]] = parseGate(rshift, m[1], m[2])
 		} else {
-			log.Fatalf("unrecognized instruction: %s", line)
+			return nil, l.Errorf(1, line, "unrecognized instruction")
 		}
 	}
-	return gates
+	return gates, nil
 }
 
-func evaluate(gates map[string]gate, cache map[string]uint16, wire string) uint16 {
+func evaluate(gates map[string]gate, cache map[string]uint16, wire string) (uint16, error) {
 	if value, ok := cache[wire]; ok {
-		return value
+		return value, nil
 	}
 	gate, ok := gates[wire]
 	if !ok {
-		log.Fatalf("undefined wire: %s", wire)
+		return 0, fmt.Errorf("undefined wire: %s", wire)
 	}
-	resolve := func(wire string, literal uint16) uint16 {
+	resolve := func(wire string, literal uint16) (uint16, error) {
 		if wire != "" {
 			return evaluate(gates, cache, wire)
 		}
-		return literal
+		return literal, nil
+	}
+	lValue, err := resolve(gate.lWire, gate.lValue)
+	if err != nil {
+		return 0, err
+	}
+	rValue, err := resolve(gate.rWire, gate.rValue)
+	if err != nil {
+		return 0, err
 	}
-	lValue := resolve(gate.lWire, gate.lValue)
-	rValue := resolve(gate.rWire, gate.rValue)
 
 	var value uint16
 	switch gate.op {
@@ -109,46 +120,59 @@ func evaluate(gates map[string]gate, cache map[string]uint16, wire string) uint1
 	case rshift:
 		value = lValue >> rValue
 	default:
-		log.Fatalf("invalid operation: %d", gate.op)
+		return 0, fmt.Errorf("invalid operation: %d", gate.op)
 	}
 	cache[wire] = value
-	return value
+	return value, nil
 }
 
-func main() {
-	day := 7
-
-	// Grab the command line parameters (file path and part number)
-	filePath, part := setup.Parameters(day)
-
-	// Print a banner showing the current day and if it is part 1 or part 2
-	setup.Banner(day, part)
+type solver struct {
+	circuit map[string]gate
+	wireA   uint16 // Value of wire a, valid if solvedA is true
+	solvedA bool
+}
 
-	// Load the data from the specified file. Abort on error.
-	lines, err := load.Lines(filePath)
+// Parse builds the circuit.
+func (s *solver) Parse(path string) error {
+	lines, err := load.Numbered(path)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
+	s.circuit, err = buildCircuit(lines)
+	s.solvedA = false
+	return err
+}
 
-	// build the circuit
-	circuit := buildCircuit(lines)
+// valueOfA returns the value of wire a, evaluating the circuit only the first time.
+func (s *solver) valueOfA() (uint16, error) {
+	if !s.solvedA {
+		value, err := evaluate(s.circuit, make(map[string]uint16), "a")
+		if err != nil {
+			return 0, err
+		}
+		s.wireA = value
+		s.solvedA = true
+	}
+	return s.wireA, nil
+}
 
-	// evaluate the circuit
-	cache := make(map[string]uint16)
-	value := evaluate(circuit, cache, "a")
+// Tags returns the algorithms and techniques used by the solution.
+func (s *solver) Tags() []string {
+	return []string{"Memoization"}
+}
 
-	// Part 1
-	if part == 1 {
-		// Print the answer for part 1
-		fmt.Printf("Value of wire a: %d\n", value)
-		return
-	}
+// Part1 returns the value of wire a.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	return s.valueOfA()
+}
 
-	// Part 2
-	if part == 2 {
-		cache2 := make(map[string]uint16)
-		cache2["b"] = value
-		value2 := evaluate(circuit, cache2, "a")
-		fmt.Printf("Value of wire a with b overridden: %d\n", value2)
+// Part2 returns the value of wire a after overriding wire b with the value of wire a from part 1.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	value, err := s.valueOfA()
+	if err != nil {
+		return nil, err
 	}
+	cache := make(map[string]uint16)
+	cache["b"] = value
+	return evaluate(s.circuit, cache, "a")
 }
@@ -0,0 +1,84 @@
+package day07
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+)
+
+func TestEvaluate_Example(t *testing.T) {
+	lines, err := load.Numbered("../../../../data/2015/day07/day07-example1.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	circuit, err := buildCircuit(lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]uint16{
+		"d": 72,
+		"e": 507,
+		"f": 492,
+		"g": 114,
+		"h": 65412,
+		"i": 65079,
+		"x": 123,
+		"y": 456,
+	}
+	cache := make(map[string]uint16)
+	for wire, want := range expected {
+		got, err := evaluate(circuit, cache, wire)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("wire %s: expected %d, got %d", wire, want, got)
+		}
+	}
+}
+
+// numbered returns the lines as the lines of an unnamed file.
+func numbered(texts ...string) []load.Line {
+	lines := make([]load.Line, len(texts))
+	for i, text := range texts {
+		lines[i] = load.Line{Number: i + 1, Text: text}
+	}
+	return lines
+}
+
+func TestBuildCircuit_Unrecognized(t *testing.T) {
+	_, err := buildCircuit(numbered("1 -> x", "x XOR y -> z"))
+	var pe *load.ParseError
+	if !errors.As(err, &pe) || pe.Line != 2 || pe.Text != "x XOR y -> z" {
+		t.Fatalf("expected error for unrecognized instruction at line 2, got %v", err)
+	}
+}
+
+func TestEvaluate_UndefinedWire(t *testing.T) {
+	circuit, err := buildCircuit(numbered("x AND y -> a", "1 -> x"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := evaluate(circuit, make(map[string]uint16), "a"); err == nil {
+		t.Fatal("expected error for undefined wire")
+	}
+}
+
+func TestPart2_ReusesPart1(t *testing.T) {
+	circuit, err := buildCircuit(numbered("b -> a", "5 -> b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &solver{circuit: circuit}
+	if got, _ := s.Part1(t.Context()); got != uint16(5) {
+		t.Fatalf("part 1: expected 5, got %v", got)
+	}
+
+	// Changing the circuit after part 1 must not change the value of wire a used by part 2.
+	s.circuit["b"] = gate{op: assign, lValue: 9}
+	if got, _ := s.Part2(t.Context()); got != uint16(5) {
+		t.Fatalf("part 2: expected 5, got %v", got)
+	}
+}
@@ -0,0 +1,104 @@
+package day08
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 8, func() setup.Solver { return &solver{} })
+}
+
+func processNext(line string, i int) (rune, int, error) {
+	char := line[i]
+	var value rune
+	if char == '\\' {
+		if i+1 >= len(line) {
+			return 0, i, fmt.Errorf("incomplete escape sequence in %s", line)
+		}
+		next := line[i+1]
+		switch next {
+		case '\\', '"':
+			value = rune(next)
+			i += 2
+		case 'x':
+			if i+4 > len(line) {
+				return 0, i, fmt.Errorf("incomplete hex escape sequence in %s", line)
+			}
+			c, err := strconv.ParseUint(line[i+2:i+4], 16, 8)
+			if err != nil {
+				return 0, i, fmt.Errorf("invalid hex escape sequence: %s", line[i+2:i+4])
+			}
+			value = rune(c)
+			i += 4
+		default:
+			return 0, i, fmt.Errorf("unexpected escape sequence: \\%c", next)
+		}
+	} else {
+		value = rune(char)
+		i++
+	}
+	return value, i, nil
+}
+
+func encode(char rune) []rune {
+	switch char {
+	case '\\':
+		return []rune{'\\', '\\'}
+	case '"':
+		return []rune{'\\', '"'}
+	default:
+		return []rune{char}
+	}
+}
+
+type solver struct {
+	lines []string
+}
+
+// Parse loads the string literals.
+func (s *solver) Parse(path string) (err error) {
+	s.lines, err = load.Lines(path)
+	return err
+}
+
+// Part1 returns the difference between the number of characters of code and the number of characters in memory.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	var characters []rune
+	totalLineLength := 0
+	for _, line := range s.lines {
+		length := len(line)
+		totalLineLength += length
+		i := 1             // Skip the first character, which is a double quote.
+		for i < length-1 { // Skip the last character, which is a double quote.
+			value, nextIndex, err := processNext(line, i)
+			if err != nil {
+				return nil, err
+			}
+			characters = append(characters, value)
+			i = nextIndex
+		}
+	}
+
+	return totalLineLength - len(characters), nil
+}
+
+// Part2 returns the difference between the number of characters of the encoded code and the original code.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	totalLineLength := 0
+	var encoded []rune
+	for _, line := range s.lines {
+		totalLineLength += len(line)
+		encoded = append(encoded, '"') // Add the starting double quote for the encoded string.
+		for _, char := range line {
+			encoded = append(encoded, encode(char)...)
+		}
+		encoded = append(encoded, '"') // Add the ending double quote for the encoded string.
+	}
+
+	return len(encoded) - totalLineLength, nil
+}
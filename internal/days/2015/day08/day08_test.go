@@ -0,0 +1,34 @@
+package day08
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+const example = `""
+"abc"
+"aaa\"aaa"
+"\x27"
+`
+
+func TestPart1_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{}, example, 1); got != 12 {
+		t.Fatalf("expected 12, got %v", got)
+	}
+}
+
+func TestPart2_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{}, example, 2); got != 19 {
+		t.Fatalf("expected 19, got %v", got)
+	}
+}
+
+func TestPart1_InvalidEscape(t *testing.T) {
+	for _, line := range []string{`"\q"`, `"\xzz"`, `"\x4"`} {
+		s := &solver{lines: []string{line}}
+		if _, err := s.Part1(t.Context()); err == nil {
+			t.Errorf("%s: expected error", line)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package day09
+
+import (
+	"context"
+	"math"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+	"github.com/jambolo/advent-of-code-2015/internal/utils"
+)
+
+func init() {
+	setup.Register(2015, 9, func() setup.Solver { return &solver{} })
+}
+
+type stringSet map[string]struct{}
+
+// leg is the distance between two cities.
+type leg struct {
+	From     string
+	To       string
+	Distance int
+}
+
+var legPattern = load.MustCompile[leg]("{From:word} to {To:word} = {Distance:int}")
+
+type solver struct {
+	cities    []string
+	distances map[[2]string]int
+	shortest  int // Distance of the shortest route, valid if routed is true
+	longest   int // Distance of the longest route, valid if routed is true
+	routed    bool
+}
+
+// Parse parses the distances and collects the unique city names.
+func (s *solver) Parse(path string) error {
+	legs, err := legPattern.Lines(path)
+	if err != nil {
+		return err
+	}
+
+	s.distances = make(map[[2]string]int)
+	citySet := make(stringSet)
+	for _, l := range legs {
+		s.distances[[2]string{l.From, l.To}] = l.Distance
+		s.distances[[2]string{l.To, l.From}] = l.Distance
+		citySet[l.From] = struct{}{}
+		citySet[l.To] = struct{}{}
+	}
+	s.cities = nil
+	for city := range citySet {
+		s.cities = append(s.cities, city)
+	}
+	s.routed = false
+	return nil
+}
+
+// routeDistances returns the distances of the shortest and longest routes visiting every city. The routes are only
+// computed the first time.
+func (s *solver) routeDistances() (int, int) {
+	if s.routed {
+		return s.shortest, s.longest
+	}
+
+	allRoutes := utils.Permutations(len(s.cities), len(s.cities))
+	minDistance := math.MaxInt
+	maxDistance := 0
+	for _, route := range allRoutes {
+		var totalDistance int
+		for i := range route[:len(route)-1] {
+			city0 := s.cities[route[i]]
+			city1 := s.cities[route[i+1]]
+			totalDistance += s.distances[[2]string{city0, city1}]
+		}
+		minDistance = min(minDistance, totalDistance)
+		maxDistance = max(maxDistance, totalDistance)
+	}
+	s.shortest, s.longest, s.routed = minDistance, maxDistance, true
+	return minDistance, maxDistance
+}
+
+// Tags returns the algorithms and techniques used by the solution.
+func (s *solver) Tags() []string {
+	return []string{"Permutations"}
+}
+
+// Part1 returns the distance of the shortest route.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	minDistance, _ := s.routeDistances()
+	return minDistance, nil
+}
+
+// Part2 returns the distance of the longest route.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	_, maxDistance := s.routeDistances()
+	return maxDistance, nil
+}
@@ -0,0 +1,24 @@
+package day09
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+const example = `London to Dublin = 464
+London to Belfast = 518
+Dublin to Belfast = 141
+`
+
+func TestPart1_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{}, example, 1); got != 605 {
+		t.Fatalf("expected 605, got %v", got)
+	}
+}
+
+func TestPart2_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{}, example, 2); got != 982 {
+		t.Fatalf("expected 982, got %v", got)
+	}
+}
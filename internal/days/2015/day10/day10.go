@@ -0,0 +1,83 @@
+package day10
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 10, func() setup.Solver { return &solver{} })
+}
+
+func lookAndSay(input string) string {
+	var b strings.Builder
+	length := len(input)
+	for i := 0; i < length; {
+		ch := input[i]
+		count := 1
+		for i+count < length && input[i+count] == ch {
+			count++
+		}
+		b.WriteString(strconv.Itoa(count))
+		b.WriteByte(ch)
+		i += count
+	}
+	return b.String()
+}
+
+type solver struct {
+	seed string
+}
+
+// Parse loads the starting sequence of digits.
+func (s *solver) Parse(path string) error {
+	seed, err := load.All(path)
+	if err != nil {
+		return err
+	}
+	s.seed = strings.TrimSpace(seed)
+	return nil
+}
+
+// Flags defines a flag that overrides the starting sequence.
+func (s *solver) Flags(fs *flag.FlagSet) {
+	fs.StringVar(&s.seed, "seed", s.seed, "Starting sequence of digits (overrides the input)")
+}
+
+// Validate checks that the starting sequence is made of digits only.
+func (s *solver) Validate() error {
+	if s.seed == "" || strings.Trim(s.seed, "0123456789") != "" {
+		return fmt.Errorf("invalid sequence: %q", s.seed)
+	}
+	return nil
+}
+
+// lengthAfter returns the length of the result of applying look-and-say to the seed the given number of times.
+func (s *solver) lengthAfter(iterations int) int {
+	input := s.seed
+	for range iterations {
+		input = lookAndSay(input)
+	}
+	return len(input)
+}
+
+// Tags returns the algorithms and techniques used by the solution.
+func (s *solver) Tags() []string {
+	return []string{"Look-and-say / run-length encoding"}
+}
+
+// Part1 returns the length of the result after 40 iterations.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	return s.lengthAfter(40), nil
+}
+
+// Part2 returns the length of the result after 50 iterations.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	return s.lengthAfter(50), nil
+}
@@ -0,0 +1,45 @@
+package day10
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestLookAndSay_Examples(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1", "11"},
+		{"11", "21"},
+		{"21", "1211"},
+		{"1211", "111221"},
+		{"111221", "312211"},
+	}
+	for _, tt := range tests {
+		if got := lookAndSay(tt.input); got != tt.expected {
+			t.Errorf("%s: expected %s, got %s", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestLengthAfter(t *testing.T) {
+	s := &solver{seed: "1"}
+	if got := s.lengthAfter(5); got != len("312211") {
+		t.Fatalf("expected %d, got %d", len("312211"), got)
+	}
+}
+
+func TestParse(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(setuptest.Input(t, "1113222113\n")); err != nil {
+		t.Fatal(err)
+	}
+	if s.seed != "1113222113" {
+		t.Fatalf("expected 1113222113, got %q", s.seed)
+	}
+	if err := s.Parse(setuptest.Input(t, "12a\n")); err != nil || s.Validate() == nil {
+		t.Fatalf("expected a non-digit to be parsed and then rejected by Validate, got %v", err)
+	}
+}
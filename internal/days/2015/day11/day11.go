@@ -0,0 +1,134 @@
+package day11
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 11, func() setup.Solver { return &solver{} })
+}
+
+// hasStraight returns true if the password includes an increasing straight of at least three letters.
+func hasStraight(password string) bool {
+	for i := range len(password)-2 {
+		if password[i]+1 == password[i+1] && password[i]+2 == password[i+2] {
+			return true
+		}
+	}
+	return false
+}
+
+// hasNoInvalidCharacters returns true if the password does not contain the letters i, o, or l.
+func hasNoInvalidCharacters(password string) bool {
+	return !strings.ContainsAny(password, "iol")
+}
+
+// hasTwoPairs returns true if the password contains at least two different, non-overlapping pairs of letters.
+func hasTwoPairs(password string) bool {
+	for i := range len(password)-3 {
+		if password[i] == password[i+1] {
+			// Found a pair, now look for another pair
+			for j := i + 2; j < len(password)-1; j++ {
+				if password[j] == password[j+1] && password[j] != password[i] {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func isValid(password string) bool {
+	return hasStraight(password) && hasNoInvalidCharacters(password) && hasTwoPairs(password)
+}
+
+func incrementPassword(password string) string {
+	runes := []rune(password)
+	for i := len(runes) - 1; i >= 0; i-- {
+		if runes[i] == 'z' {
+			runes[i] = 'a'
+		} else {
+			runes[i]++
+			break
+		}
+	}
+	return string(runes)
+}
+
+// minLength is the length of the shortest passwords that can be valid, such as "aabcc". The search for the next valid
+// password ends for any password of at least this length, because it wraps around all passwords of the same length.
+const minLength = 5
+
+// nextValid returns the next valid password after the given password. The search stops when the budget does.
+func nextValid(budget *setup.Budget, password string) (string, error) {
+	password = incrementPassword(password)
+	for !isValid(password) {
+		if err := budget.Step(); err != nil {
+			return "", err
+		}
+		password = incrementPassword(password)
+	}
+	return password, nil
+}
+
+type solver struct {
+	password string
+	next     string // The next valid password, or empty if not found yet
+}
+
+// Parse loads the current password.
+func (s *solver) Parse(path string) error {
+	password, err := load.All(path)
+	if err != nil {
+		return err
+	}
+	s.password = strings.TrimSpace(password)
+	s.next = ""
+	return nil
+}
+
+// Flags defines a flag that overrides the current password.
+func (s *solver) Flags(fs *flag.FlagSet) {
+	fs.StringVar(&s.password, "password", s.password, "Current password (overrides the input)")
+}
+
+// Validate checks that the password is made of at least minLength lowercase letters, so that a valid password follows
+// it.
+func (s *solver) Validate() error {
+	if len(s.password) < minLength || strings.Trim(s.password, "abcdefghijklmnopqrstuvwxyz") != "" {
+		return fmt.Errorf("invalid password: %q", s.password)
+	}
+	return nil
+}
+
+// nextPassword returns the next valid password, finding it only the first time.
+func (s *solver) nextPassword(ctx context.Context) (string, error) {
+	if s.next == "" {
+		next, err := nextValid(setup.NewBudget(ctx), s.password)
+		if err != nil {
+			return "", err
+		}
+		s.next = next
+	}
+	return s.next, nil
+}
+
+// Part1 returns the next valid password.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	return s.nextPassword(ctx)
+}
+
+// Part2 returns the valid password after the next one.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	next, err := s.nextPassword(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return nextValid(setup.NewBudget(ctx), next)
+}
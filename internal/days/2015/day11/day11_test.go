@@ -0,0 +1,75 @@
+package day11
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestIsValid_Examples(t *testing.T) {
+	tests := []struct {
+		password string
+		expected bool
+	}{
+		{"hijklmmn", false},
+		{"abbceffg", false},
+		{"abbcegjk", false},
+		{"abcdffaa", true},
+		{"ghjaabcc", true},
+	}
+	for _, tt := range tests {
+		if got := isValid(tt.password); got != tt.expected {
+			t.Errorf("%s: expected %v, got %v", tt.password, tt.expected, got)
+		}
+	}
+}
+
+func TestIncrementPassword_Wraps(t *testing.T) {
+	if got := incrementPassword("xz"); got != "ya" {
+		t.Fatalf("expected ya, got %s", got)
+	}
+}
+
+func TestPart1_Example(t *testing.T) {
+	s := &solver{password: "abcdefgh"}
+	got, err := s.Part1(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "abcdffaa" {
+		t.Fatalf("expected abcdffaa, got %v", got)
+	}
+}
+
+func TestParse(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(setuptest.Input(t, "abcdefgh\n")); err != nil {
+		t.Fatal(err)
+	}
+	if s.password != "abcdefgh" {
+		t.Fatalf("expected abcdefgh, got %q", s.password)
+	}
+	if err := s.Parse(setuptest.Input(t, "ABC\n")); err != nil || s.Validate() == nil {
+		t.Fatalf("expected an uppercase letter to be parsed and then rejected by Validate, got %v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, password := range []string{"", "A", "abcd", "abcdefgH"} {
+		s := &solver{password: password}
+		if err := s.Validate(); err == nil {
+			t.Errorf("%q: expected an error", password)
+		}
+	}
+}
+
+func TestPart2_Timeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 0)
+	defer cancel()
+	s := &solver{password: "abcdefgh"}
+	if _, err := s.Part2(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+}
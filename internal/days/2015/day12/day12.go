@@ -1,13 +1,16 @@
-package main
+package day12
 
 import (
-	"fmt"
-	"log"
+	"context"
 
 	"github.com/jambolo/advent-of-code-2015/internal/load"
 	"github.com/jambolo/advent-of-code-2015/internal/setup"
 )
 
+func init() {
+	setup.Register(2015, 12, func() setup.Solver { return &solver{} })
+}
+
 func sumAllNumbers(data any) int {
 	sum := 0
 
@@ -58,31 +61,21 @@ func sumAllNumbersWithoutRed(data any) int {
 	return sum
 }
 
-func main() {
-	day := 12
-
-	// Grab the command line parameters (file path and part number)
-	filePath, part := setup.Parameters(day)
-
-	// Print a banner showing the current day and if it is part 1 or part 2
-	setup.Banner(day, part)
+type solver struct {
+	input any
+}
 
-	// Load the data from the specified file. Abort on error.
-	var input any
-	err := load.Json(filePath, &input)
-	if err != nil {
-		log.Fatal(err)
-	}
+// Parse loads the JSON document.
+func (s *solver) Parse(path string) error {
+	return load.Json(path, &s.input)
+}
 
-	// Part 1
-	if part == 1 {
-		sum := sumAllNumbers(input)
-		fmt.Printf("Sum of all numbers: %d\n", sum)
-	}
+// Part1 returns the sum of all numbers in the document.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	return sumAllNumbers(s.input), nil
+}
 
-	// Part 2
-	if part == 2 {
-		sum := sumAllNumbersWithoutRed(input)
-		fmt.Printf("Sum of all numbers without red: %d\n", sum)
-	}
+// Part2 returns the sum of all numbers in the document, ignoring objects with a "red" value.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	return sumAllNumbersWithoutRed(s.input), nil
 }
@@ -0,0 +1,45 @@
+package day12
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestPart1_Examples(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{`[1,2,3]`, 6},
+		{`{"a":2,"b":4}`, 6},
+		{`[[[3]]]`, 3},
+		{`{"a":{"b":4},"c":-1}`, 3},
+		{`{"a":[-1,1]}`, 0},
+		{`[-1,{"a":1}]`, 0},
+		{`[]`, 0},
+		{`{}`, 0},
+	}
+	for _, tt := range tests {
+		if got := setuptest.Solve(t, &solver{}, tt.input, 1); got != tt.expected {
+			t.Errorf("%s: expected %d, got %v", tt.input, tt.expected, got)
+		}
+	}
+}
+
+func TestPart2_Examples(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int
+	}{
+		{`[1,2,3]`, 6},
+		{`[1,{"c":"red","b":2},3]`, 4},
+		{`{"d":"red","e":[1,2,3,4],"f":5}`, 0},
+		{`[1,"red",5]`, 6},
+	}
+	for _, tt := range tests {
+		if got := setuptest.Solve(t, &solver{}, tt.input, 2); got != tt.expected {
+			t.Errorf("%s: expected %d, got %v", tt.input, tt.expected, got)
+		}
+	}
+}
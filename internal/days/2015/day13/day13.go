@@ -0,0 +1,119 @@
+package day13
+
+import (
+	"context"
+	"math"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+	"github.com/jambolo/advent-of-code-2015/internal/utils"
+)
+
+func init() {
+	setup.Register(2015, 13, func() setup.Solver { return &solver{} })
+}
+
+type relationshipMap map[string]map[string]int
+
+// relationship is the change in happiness of a person sitting next to a neighbor.
+type relationship struct {
+	Person    string
+	Change    string
+	Happiness int
+	Neighbor  string
+}
+
+var relationshipPattern = load.MustCompile[relationship](
+	"{Person:word} would {Change:gain|lose} {Happiness:int} happiness units by sitting next to {Neighbor:word}.")
+
+func computeGroupHappiness(p []int, people []string, relationships relationshipMap) int {
+	happiness := 0
+	n := len(p)
+	for i := range n {
+		person1 := people[p[i]]
+		person2 := people[p[(i+1)%n]]
+		h0 := relationships[person1][person2]
+		h1 := relationships[person2][person1]
+		happiness += h0 + h1
+	}
+	return happiness
+}
+
+// maxHappiness returns the total change in happiness of the best seating arrangement of the people.
+func maxHappiness(people []string, relationships relationshipMap) int {
+	// Generate all permutations of people
+	numberOfPeople := len(people)
+	permutations := utils.Permutations(numberOfPeople, numberOfPeople)
+
+	// Score each permutation and find the maximum
+	maxHappiness := math.MinInt
+	for _, p := range permutations {
+		happiness := computeGroupHappiness(p, people, relationships)
+		if happiness > maxHappiness {
+			maxHappiness = happiness
+		}
+	}
+	return maxHappiness
+}
+
+type solver struct {
+	people        []string
+	relationships relationshipMap
+}
+
+// Parse builds the relationship map and the list of people.
+func (s *solver) Parse(path string) error {
+	relationships, err := relationshipPattern.Lines(path)
+	if err != nil {
+		return err
+	}
+
+	// Build relationship map
+	s.relationships = make(relationshipMap)
+	for _, r := range relationships {
+		happiness := r.Happiness
+		if r.Change == "lose" {
+			happiness = -happiness
+		}
+
+		if s.relationships[r.Person] == nil {
+			s.relationships[r.Person] = make(map[string]int)
+		}
+		s.relationships[r.Person][r.Neighbor] = happiness
+	}
+
+	// Create a list of people
+	s.people = nil
+	for person := range s.relationships {
+		s.people = append(s.people, person)
+	}
+	return nil
+}
+
+// Tags returns the algorithms and techniques used by the solution.
+func (s *solver) Tags() []string {
+	return []string{"Permutations"}
+}
+
+// Part1 returns the total change in happiness of the best seating arrangement.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	return maxHappiness(s.people, s.relationships), nil
+}
+
+// Part2 returns the total change in happiness of the best seating arrangement including me.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	// Add "me" to copies of the list of people and relationships
+	people := append([]string(nil), s.people...)
+	people = append(people, "me")
+	relationships := relationshipMap{"me": make(map[string]int)}
+	for _, person := range s.people {
+		relationships[person] = make(map[string]int)
+		for other, happiness := range s.relationships[person] {
+			relationships[person][other] = happiness
+		}
+		relationships[person]["me"] = 0
+		relationships["me"][person] = 0
+	}
+
+	return maxHappiness(people, relationships), nil
+}
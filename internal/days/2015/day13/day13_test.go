@@ -0,0 +1,39 @@
+package day13
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+const example = `Alice would gain 54 happiness units by sitting next to Bob.
+Alice would lose 79 happiness units by sitting next to Carol.
+Alice would lose 2 happiness units by sitting next to David.
+Bob would gain 83 happiness units by sitting next to Alice.
+Bob would lose 7 happiness units by sitting next to Carol.
+Bob would lose 63 happiness units by sitting next to David.
+Carol would lose 62 happiness units by sitting next to Alice.
+Carol would gain 60 happiness units by sitting next to Bob.
+Carol would gain 55 happiness units by sitting next to David.
+David would gain 46 happiness units by sitting next to Alice.
+David would lose 7 happiness units by sitting next to Bob.
+David would gain 41 happiness units by sitting next to Carol.
+`
+
+func TestPart1_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{}, example, 1); got != 330 {
+		t.Fatalf("expected 330, got %v", got)
+	}
+}
+
+func TestPart2_DoesNotChangePart1(t *testing.T) {
+	s := &solver{}
+	setuptest.Solve(t, s, example, 2)
+	got, err := s.Part1(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 330 {
+		t.Fatalf("expected 330, got %v", got)
+	}
+}
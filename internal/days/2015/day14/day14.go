@@ -0,0 +1,115 @@
+package day14
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+	"github.com/jambolo/advent-of-code-2015/internal/utils"
+)
+
+func init() {
+	setup.Register(2015, 14, func() setup.Solver { return &solver{totalTime: 2503} })
+}
+
+// isFlying returns true if the reindeer is flying at the given time, false if it is resting.
+func isFlying(time int, flyTime int, cycleTime int) bool {
+	return (time-1)%cycleTime < flyTime
+}
+
+type reindeer struct {
+	speed, flyTime, restTime, cycleTime, cycleDistance int
+}
+
+// description is a reindeer as it is described in the input.
+type description struct {
+	Name     string
+	Speed    int
+	FlyTime  int
+	RestTime int
+}
+
+var descriptionPattern = load.MustCompile[description](
+	"{Name:word} can fly {Speed:int} km/s for {FlyTime:int} seconds, but then must rest for {RestTime:int} seconds.")
+
+type solver struct {
+	reindeers map[string]reindeer
+	totalTime int
+}
+
+// Parse parses the description of each reindeer.
+func (s *solver) Parse(path string) error {
+	descriptions, err := descriptionPattern.Lines(path)
+	if err != nil {
+		return err
+	}
+
+	s.reindeers = make(map[string]reindeer)
+	for _, d := range descriptions {
+		s.reindeers[d.Name] = reindeer{
+			speed:         d.Speed,
+			flyTime:       d.FlyTime,
+			restTime:      d.RestTime,
+			cycleTime:     d.FlyTime + d.RestTime,
+			cycleDistance: d.Speed * d.FlyTime,
+		}
+	}
+	return nil
+}
+
+// Part1 returns the distance traveled by the winning reindeer.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	if len(s.reindeers) == 0 {
+		return nil, errors.New("no reindeer")
+	}
+
+	var distances []int
+	for _, r := range s.reindeers {
+		cycles := s.totalTime / r.cycleTime
+		timeInLastCycle := s.totalTime % r.cycleTime
+		distanceInLastCycle := min(r.flyTime, timeInLastCycle) * r.speed // After last full cycle
+		distances = append(distances, cycles*r.cycleDistance+distanceInLastCycle)
+	}
+
+	return utils.SliceMax(distances), nil
+}
+
+// Part2 returns the points of the winning reindeer.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	distances := make(map[string]int)
+	for name := range s.reindeers {
+		distances[name] = 0
+	}
+
+	points := make(map[string]int)
+	for name := range s.reindeers {
+		points[name] = 0
+	}
+
+	for t := 1; t <= s.totalTime; t++ {
+		// Update the distances for each reindeer and track the leading distance
+		leadingDistance := 0
+		for name, r := range s.reindeers {
+			if isFlying(t, r.flyTime, r.cycleTime) {
+				distances[name] = distances[name] + r.speed
+			}
+			leadingDistance = max(leadingDistance, distances[name])
+		}
+
+		// Award points to the reindeer(s) in the lead
+		for name, distance := range distances {
+			if distance >= leadingDistance {
+				points[name] = points[name] + 1
+			}
+		}
+	}
+
+	// Find the reindeer with the most points
+	mostPoints := 0
+	for _, p := range points {
+		mostPoints = max(mostPoints, p)
+	}
+
+	return mostPoints, nil
+}
@@ -0,0 +1,30 @@
+package day14
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+const example = `Comet can fly 14 km/s for 10 seconds, but then must rest for 127 seconds.
+Dancer can fly 16 km/s for 11 seconds, but then must rest for 162 seconds.
+`
+
+func TestPart1_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{totalTime: 1000}, example, 1); got != 1120 {
+		t.Fatalf("expected 1120, got %v", got)
+	}
+}
+
+func TestPart2_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{totalTime: 1000}, example, 2); got != 689 {
+		t.Fatalf("expected 689, got %v", got)
+	}
+}
+
+func TestParse_UnexpectedFormat(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(setuptest.Input(t, "Comet can run 14 km/s\n")); err == nil {
+		t.Fatal("expected error for unexpected line format")
+	}
+}
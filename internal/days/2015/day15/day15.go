@@ -0,0 +1,88 @@
+package day15
+
+import (
+	"context"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+	"github.com/jambolo/advent-of-code-2015/internal/utils"
+)
+
+func init() {
+	setup.Register(2015, 15, func() setup.Solver { return &solver{} })
+}
+
+type ingredient struct {
+	Name       string
+	Capacity   int
+	Durability int
+	Flavor     int
+	Texture    int
+	Calories   int
+}
+
+var ingredientPattern = load.MustCompile[ingredient]("{Name}: capacity {Capacity:int}, durability {Durability:int}, " +
+	"flavor {Flavor:int}, texture {Texture:int}, calories {Calories:int}")
+
+// bestScore returns the best score of all cookies. If calories is not 0, only cookies with exactly that many calories
+// are considered.
+func bestScore(ingredients []ingredient, calories int) int {
+	ingredientCount := len(ingredients)
+
+	compositions := utils.Compositions(100, ingredientCount)
+	bestScore := 0
+	for _, c := range compositions {
+		totalCapacity := 0
+		totalDurability := 0
+		totalFlavor := 0
+		totalTexture := 0
+		totalCalories := 0
+
+		for i, amount := range c {
+			totalCapacity += amount * ingredients[i].Capacity
+			totalDurability += amount * ingredients[i].Durability
+			totalFlavor += amount * ingredients[i].Flavor
+			totalTexture += amount * ingredients[i].Texture
+			totalCalories += amount * ingredients[i].Calories
+		}
+
+		totalCapacity = max(totalCapacity, 0)
+		totalDurability = max(totalDurability, 0)
+		totalFlavor = max(totalFlavor, 0)
+		totalTexture = max(totalTexture, 0)
+		totalCalories = max(totalCalories, 0)
+
+		score := totalCapacity * totalDurability * totalFlavor * totalTexture
+
+		if calories == 0 || totalCalories == calories {
+			bestScore = max(bestScore, score)
+		}
+	}
+	return bestScore
+}
+
+type solver struct {
+	ingredients []ingredient
+}
+
+// Parse parses the properties of each ingredient.
+func (s *solver) Parse(path string) error {
+	var err error
+	s.ingredients, err = ingredientPattern.Lines(path)
+	return err
+}
+
+// Tags returns the algorithms and techniques used by the solution.
+func (s *solver) Tags() []string {
+	return []string{"Compositions (stars and bars)"}
+}
+
+// Part1 returns the score of the best cookie.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	return bestScore(s.ingredients, 0), nil
+}
+
+// Part2 returns the score of the best cookie with exactly 500 calories.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	return bestScore(s.ingredients, 500), nil
+}
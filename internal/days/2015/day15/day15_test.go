@@ -0,0 +1,23 @@
+package day15
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+const example = `Butterscotch: capacity -1, durability -2, flavor 6, texture 3, calories 8
+Cinnamon: capacity 2, durability 3, flavor -2, texture -1, calories 3
+`
+
+func TestPart1_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{}, example, 1); got != 62842880 {
+		t.Fatalf("expected 62842880, got %v", got)
+	}
+}
+
+func TestPart2_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{}, example, 2); got != 57600000 {
+		t.Fatalf("expected 57600000, got %v", got)
+	}
+}
@@ -0,0 +1,134 @@
+package day16
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 16, func() setup.Solver { return &solver{} })
+}
+
+var mfcsam = map[string]int{
+	"children":    3,
+	"cats":        7,
+	"samoyeds":    2,
+	"pomeranians": 3,
+	"akitas":      0,
+	"vizslas":     0,
+	"goldfish":    5,
+	"trees":       3,
+	"cars":        2,
+	"perfumes":    1,
+}
+
+type sue map[string]int
+
+func matchesSuePart1(sue sue) bool {
+	for name, value := range sue {
+		if mfcsam[name] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesSuePart2(sue sue) bool {
+	for name, value := range sue {
+		switch name {
+		case "cats", "trees":
+			if mfcsam[name] >= value {
+				return false
+			}
+		case "pomeranians", "goldfish":
+			if mfcsam[name] <= value {
+				return false
+			}
+		default:
+			if mfcsam[name] != value {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// record is the description of a Sue in the input, whose properties are separated by commas.
+type record struct {
+	Number     int
+	Properties string
+}
+
+// property is a property of a Sue and its value.
+type property struct {
+	Name  string
+	Value int
+}
+
+var (
+	recordPattern   = load.MustCompile[record]("Sue {Number:int}: {Properties}")
+	propertyPattern = load.MustCompile[property]("{Name:word}: {Value:int}")
+)
+
+type solver struct {
+	sues []sue
+}
+
+// Parse parses the known properties of each Sue.
+func (s *solver) Parse(path string) error {
+	lines, err := load.Numbered(path)
+	if err != nil {
+		return err
+	}
+
+	s.sues = make([]sue, 500)
+	for _, line := range lines {
+		r, err := recordPattern.Parse(line.Text)
+		if err != nil {
+			return line.At(1, err)
+		}
+		if r.Number < 1 || r.Number > 500 {
+			return line.Errorf(len("Sue ")+1, strconv.Itoa(r.Number), "invalid sue number, must be 1 to 500")
+		}
+
+		n := r.Number - 1
+		if s.sues[n] == nil {
+			s.sues[n] = make(sue)
+		}
+		column := len(line.Text) - len(r.Properties) + 1
+		for text := range strings.SplitSeq(r.Properties, ", ") {
+			p, err := propertyPattern.Parse(text)
+			if err != nil {
+				return line.At(column, err)
+			}
+			s.sues[n][p.Name] = p.Value
+			column += len(text) + len(", ")
+		}
+	}
+	return nil
+}
+
+// find returns the number of the first Sue that matches.
+func (s *solver) find(matches func(sue) bool) (any, error) {
+	for i, sue := range s.sues {
+		if matches(sue) {
+			return i + 1, nil
+		}
+	}
+	return nil, errors.New("no Sue matches")
+}
+
+// Part1 returns the number of the Sue that matches the MFCSAM readings exactly.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	return s.find(matchesSuePart1)
+}
+
+// Part2 returns the number of the Sue that matches the MFCSAM readings using ranges for some properties.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	return s.find(matchesSuePart2)
+}
@@ -0,0 +1,54 @@
+package day16
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+const input = `Sue 1: cars: 9, akitas: 3, goldfish: 0
+Sue 2: children: 3, cats: 7, perfumes: 1
+Sue 3: cats: 8, trees: 4, goldfish: 4
+`
+
+func TestPart1(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{}, input, 1); got != 2 {
+		t.Fatalf("expected 2, got %v", got)
+	}
+}
+
+func TestPart2(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{}, input, 2); got != 3 {
+		t.Fatalf("expected 3, got %v", got)
+	}
+}
+
+func TestMatchesSuePart2_Ranges(t *testing.T) {
+	if matchesSuePart2(sue{"cats": 7}) {
+		t.Error("expected cats equal to the reading to be rejected")
+	}
+	if matchesSuePart2(sue{"goldfish": 5}) {
+		t.Error("expected goldfish equal to the reading to be rejected")
+	}
+	if !matchesSuePart2(sue{"trees": 4, "pomeranians": 2}) {
+		t.Error("expected more trees and fewer pomeranians to be accepted")
+	}
+}
+
+func TestParse_InvalidSue(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(setuptest.Input(t, "Sue 501: cars: 1\n")); err == nil {
+		t.Fatal("expected error for invalid sue number")
+	}
+}
+
+func TestParse_InvalidProperty(t *testing.T) {
+	s := &solver{}
+	err := s.Parse(setuptest.Input(t, "Sue 1: cars: 9\nSue 2: cars: 1, cats: lots\n"))
+	var pe *load.ParseError
+	if !errors.As(err, &pe) || pe.Line != 2 || pe.Column != 23 || pe.Text != "lots" {
+		t.Fatalf("expected an error at line 2 column 23, got %v", err)
+	}
+}
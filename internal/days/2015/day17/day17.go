@@ -0,0 +1,62 @@
+package day17
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+	"github.com/jambolo/advent-of-code-2015/internal/utils"
+)
+
+func init() {
+	setup.Register(2015, 17, func() setup.Solver { return &solver{liters: 150} })
+}
+
+type solver struct {
+	containers []int
+	liters     int
+}
+
+// Parse loads the sizes of the containers.
+func (s *solver) Parse(path string) error {
+	var err error
+	s.containers, err = load.Ints[int](path)
+	return err
+}
+
+// countCombinations returns the number of combinations of n containers that hold exactly the target amount.
+func (s *solver) countCombinations(n int) int {
+	count := 0
+	combinations := utils.Combinations(len(s.containers), n)
+	for _, p := range combinations {
+		if utils.SliceSum(utils.Gather(p, s.containers)) == s.liters {
+			count++
+		}
+	}
+	return count
+}
+
+// Tags returns the algorithms and techniques used by the solution.
+func (s *solver) Tags() []string {
+	return []string{"Combinations"}
+}
+
+// Part1 returns the number of combinations of containers that hold exactly the target amount.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	count := 0
+	for i := 1; i <= len(s.containers); i++ {
+		count += s.countCombinations(i)
+	}
+	return count, nil
+}
+
+// Part2 returns the number of combinations of the minimum number of containers that hold exactly the target amount.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	for i := 1; i <= len(s.containers); i++ {
+		if count := s.countCombinations(i); count > 0 {
+			return count, nil
+		}
+	}
+	return nil, errors.New("no combination of containers holds the target amount")
+}
@@ -0,0 +1,28 @@
+package day17
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+const example = "20\n15\n10\n5\n5\n"
+
+func TestPart1_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{liters: 25}, example, 1); got != 4 {
+		t.Fatalf("expected 4, got %v", got)
+	}
+}
+
+func TestPart2_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{liters: 25}, example, 2); got != 3 {
+		t.Fatalf("expected 3, got %v", got)
+	}
+}
+
+func TestParse_InvalidSize(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(setuptest.Input(t, "20\n15x\n")); err == nil {
+		t.Fatal("expected error for invalid container size")
+	}
+}
@@ -0,0 +1,123 @@
+package day18
+
+import (
+	"context"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 18, func() setup.Solver { return &solver{steps: 100} })
+}
+
+func neighborsCount(m [][]byte, x, y int) int {
+	width := len(m[0])
+	height := len(m)
+	count := 0
+	for i := -1; i <= 1; i++ {
+		if y+i >= 0 && y+i < height {
+			for j := -1; j <= 1; j++ {
+				if j == 0 && i == 0 {
+					continue
+				}
+				if x+j >= 0 && x+j < width {
+					if m[y+i][x+j] == '#' {
+						count++
+					}
+				}
+			}
+		}
+	}
+	return count
+}
+
+func step(m [][]byte) [][]byte {
+	width := len(m[0])
+	height := len(m)
+	newMap := make([][]byte, height)
+	for i := range m {
+		newMap[i] = make([]byte, width)
+		for j := range m[i] {
+			count := neighborsCount(m, j, i)
+			if m[i][j] == '#' {
+				if count == 2 || count == 3 {
+					newMap[i][j] = '#'
+				} else {
+					newMap[i][j] = '.'
+				}
+			} else {
+				if count == 3 {
+					newMap[i][j] = '#'
+				} else {
+					newMap[i][j] = '.'
+				}
+			}
+		}
+	}
+	return newMap
+}
+
+// turnOnCorners turns on the four corner lights.
+func turnOnCorners(m [][]byte) {
+	m[0][0] = '#'
+	m[0][len(m[0])-1] = '#'
+	m[len(m)-1][0] = '#'
+	m[len(m)-1][len(m[0])-1] = '#'
+}
+
+type solver struct {
+	m     [][]byte
+	steps int
+}
+
+// Parse loads the initial configuration of the lights.
+func (s *solver) Parse(path string) (err error) {
+	s.m, err = load.Map(path)
+	return err
+}
+
+// animate returns the number of lights that are on after all the steps. If stuck is true, the corner lights are
+// always on.
+func (s *solver) animate(stuck bool) int {
+	m := make([][]byte, len(s.m))
+	for i := range s.m {
+		m[i] = append([]byte(nil), s.m[i]...)
+	}
+
+	if stuck {
+		turnOnCorners(m)
+	}
+
+	for range s.steps {
+		m = step(m)
+		if stuck {
+			turnOnCorners(m)
+		}
+	}
+
+	count := 0
+	for i := range m {
+		for j := range m[i] {
+			if m[i][j] == '#' {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// Tags returns the algorithms and techniques used by the solution.
+func (s *solver) Tags() []string {
+	return []string{"Cellular automaton (Game of Life)"}
+}
+
+// Part1 returns the number of lights that are on after all the steps.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	return s.animate(false), nil
+}
+
+// Part2 returns the number of lights that are on after all the steps with the corner lights stuck on.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	return s.animate(true), nil
+}
@@ -0,0 +1,34 @@
+package day18
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+const example = `.#.#.#
+...##.
+#....#
+..#...
+#.#..#
+####..
+`
+
+func TestPart1_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{steps: 4}, example, 1); got != 4 {
+		t.Fatalf("expected 4, got %v", got)
+	}
+}
+
+func TestPart2_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{steps: 5}, example, 2); got != 17 {
+		t.Fatalf("expected 17, got %v", got)
+	}
+}
+
+func TestNeighborsCount_Corner(t *testing.T) {
+	m := [][]byte{[]byte("##"), []byte("##")}
+	if got := neighborsCount(m, 0, 0); got != 3 {
+		t.Fatalf("expected 3, got %d", got)
+	}
+}
@@ -0,0 +1,189 @@
+package day19
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"math"
+	"strings"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+	"github.com/jambolo/advent-of-code-2015/internal/utils"
+)
+
+func init() {
+	setup.Register(2015, 19, func() setup.Solver { return &solver{} })
+}
+
+// Entry defines the unit stored in the queue.
+type Entry struct {
+	value string
+	g     int // Cost so far
+	f     int // g + heuristic (priority)
+}
+
+// PriorityQueue implements heap.Interface, ordered by lowest count.
+type PriorityQueue []Entry
+
+func (pq PriorityQueue) Len() int { return len(pq) }
+func (pq PriorityQueue) Less(i, j int) bool {
+	if pq[i].f == pq[j].f {
+		return len(pq[i].value) < len(pq[j].value)
+	}
+	return pq[i].f < pq[j].f
+}
+func (pq PriorityQueue) Swap(i, j int)       { pq[i], pq[j] = pq[j], pq[i] }
+func (pq *PriorityQueue) Push(x interface{}) { *pq = append(*pq, x.(Entry)) }
+func (pq *PriorityQueue) Pop() interface{} {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	*pq = old[:n-1]
+	return item
+}
+
+// heuristic returns the difference in string lengths.
+func heuristic(node, goal string) int {
+	d := len(node) - len(goal)
+	if d < 0 {
+		return -d
+	}
+	if d == 0 && node != goal {
+		return math.MaxInt
+	}
+	return d
+}
+
+func neighborsOf(m string, replacements map[string][]string) []string {
+	var neighbors []string
+	for i := range m {
+		prefix := m[:i]
+		remainder := m[i:]
+		for from, tos := range replacements {
+			if strings.HasPrefix(remainder, from) {
+				for _, to := range tos {
+					neighbors = append(neighbors, prefix+to+remainder[len(from):])
+				}
+			}
+		}
+	}
+	return neighbors
+}
+
+// progressInterval is the number of states between the progress events of a search.
+const progressInterval = 10000
+
+// aStar finds the shortest path from start to goal using A*. It returns -1 if there is no path, or an error if ctx is
+// done before the search ends.
+func aStar(ctx context.Context, start, goal string, neighborsOf func(string, map[string][]string) []string, replacements map[string][]string) (int, error) {
+	queue := &PriorityQueue{{value: start, g: 0, f: heuristic(start, goal)}}
+	heap.Init(queue)
+	visited := make(map[string]struct{})
+	budget := setup.NewBudget(ctx)
+	log := setup.Logger(ctx)
+	tracing := log.Enabled(ctx, setup.LevelTrace)
+
+	for queue.Len() > 0 {
+		if err := budget.Step(); err != nil {
+			return 0, err
+		}
+		item := heap.Pop(queue).(Entry)
+		if budget.States()%progressInterval == 0 {
+			log.Debug("searching", "states", budget.States(), "queued", queue.Len(), "visited", len(visited),
+				"steps", item.g, "length", len(item.value))
+		}
+		if tracing {
+			log.Log(ctx, setup.LevelTrace, "expanding", "steps", item.g, "estimate", item.f, "molecule", item.value)
+		}
+		if item.value == goal {
+			log.Debug("found", "states", budget.States(), "steps", item.g)
+			return item.g, nil
+		}
+		if _, ok := visited[item.value]; ok {
+			continue
+		}
+		visited[item.value] = struct{}{}
+
+		for _, neighbor := range neighborsOf(item.value, replacements) {
+			if _, ok := visited[neighbor]; !ok {
+				g := item.g + 1
+				f := g + heuristic(neighbor, goal)
+				heap.Push(queue, Entry{value: neighbor, g: g, f: f})
+			}
+		}
+	}
+	return -1, nil
+}
+
+type solver struct {
+	replacements map[string][]string
+	molecule     string
+}
+
+// Parse parses the replacements and the medicine molecule.
+func (s *solver) Parse(path string) error {
+	var err error
+	s.replacements, s.molecule, err = load.HeaderBody(path, parseReplacements, parseMolecule)
+	return err
+}
+
+// parseReplacements returns the molecules that each molecule can be replaced with.
+func parseReplacements(lines []load.Line) (map[string][]string, error) {
+	replacements := make(map[string][]string)
+	for _, line := range lines {
+		from, to, ok := strings.Cut(line.Text, " => ")
+		if !ok || from == "" || to == "" || strings.Contains(from+to, " ") {
+			return nil, line.Errorf(1, line.Text, "invalid replacement, must be \"from => to\"")
+		}
+		replacements[from] = append(replacements[from], to)
+	}
+	return replacements, nil
+}
+
+// parseMolecule returns the medicine molecule, which is a single line.
+func parseMolecule(lines []load.Line) (string, error) {
+	if len(lines) > 1 {
+		return "", lines[1].Errorf(1, lines[1].Text, "unexpected line after the molecule")
+	}
+	return lines[0].Text, nil
+}
+
+// Tags returns the algorithms and techniques used by the solution.
+func (s *solver) Tags() []string {
+	return []string{"A* search"}
+}
+
+// Part1 returns the number of distinct molecules that can be created with one replacement.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	replaced := make(map[string]struct{})
+	for i := range s.molecule {
+		prefix := s.molecule[:i]
+		remainder := s.molecule[i:]
+		for from, to := range s.replacements {
+			if strings.HasPrefix(remainder, from) {
+				for _, t := range to {
+					newMolecule := prefix + t + remainder[len(from):]
+					replaced[newMolecule] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return len(replaced), nil
+}
+
+// Part2 returns the fewest number of steps needed to make the molecule starting from "e".
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	reversed := utils.InvertMap(s.replacements)
+	goal := "e"
+
+	result, err := aStar(ctx, s.molecule, goal, neighborsOf, reversed)
+	if err != nil {
+		return nil, err
+	}
+	if result < 0 {
+		return nil, errors.New("the molecule cannot be made")
+	}
+	return result, nil
+}
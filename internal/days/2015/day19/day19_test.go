@@ -0,0 +1,70 @@
+package day19
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestPart1_Examples(t *testing.T) {
+	tests := []struct {
+		molecule string
+		expected int
+	}{
+		{"HOH", 4},
+		{"HOHOHO", 7},
+	}
+	for _, tt := range tests {
+		input := "H => HO\nH => OH\nO => HH\n\n" + tt.molecule + "\n"
+		if got := setuptest.Solve(t, &solver{}, input, 1); got != tt.expected {
+			t.Errorf("%s: expected %d, got %v", tt.molecule, tt.expected, got)
+		}
+	}
+}
+
+func TestPart2_Examples(t *testing.T) {
+	tests := []struct {
+		molecule string
+		expected int
+	}{
+		{"HOH", 3},
+		{"HOHOHO", 6},
+	}
+	for _, tt := range tests {
+		input := "e => H\ne => O\nH => HO\nH => OH\nO => HH\n\n" + tt.molecule + "\n"
+		if got := setuptest.Solve(t, &solver{}, input, 2); got != tt.expected {
+			t.Errorf("%s: expected %d, got %v", tt.molecule, tt.expected, got)
+		}
+	}
+}
+
+func TestParse_InvalidReplacement(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(setuptest.Input(t, "H -> HO\n\nHOH\n")); err == nil {
+		t.Fatal("expected error for invalid replacement")
+	}
+}
+
+func TestAStar_Timeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 0)
+	defer cancel()
+
+	// Every step lengthens the molecule, so the search never reaches the goal.
+	replacements := map[string][]string{"H": {"HH", "HO"}, "O": {"OO", "OH"}}
+	_, err := aStar(ctx, "HO", "e", neighborsOf, replacements)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+}
+
+func TestParse_MissingMolecule(t *testing.T) {
+	s := &solver{}
+	err := s.Parse(setuptest.Input(t, "H => HO\nO => HH\n"))
+	var pe *load.ParseError
+	if !errors.As(err, &pe) || pe.Line != 3 {
+		t.Fatalf("expected an error for the missing molecule at line 3, got %v", err)
+	}
+}
@@ -0,0 +1,92 @@
+package day20
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 20, func() setup.Solver { return &solver{} })
+}
+
+type solver struct {
+	maxPresents int
+}
+
+// Parse loads the target number of presents.
+func (s *solver) Parse(path string) error {
+	input, err := load.All(path)
+	if err != nil {
+		return err
+	}
+	s.maxPresents, err = strconv.Atoi(strings.TrimSpace(input))
+	if err != nil {
+		return fmt.Errorf("invalid number of presents: %w", err)
+	}
+	return nil
+}
+
+// Flags defines a flag that overrides the target number of presents.
+func (s *solver) Flags(fs *flag.FlagSet) {
+	fs.IntVar(&s.maxPresents, "presents", s.maxPresents, "Target number of presents (overrides the input)")
+}
+
+// Validate checks that the target number of presents is positive.
+func (s *solver) Validate() error {
+	if s.maxPresents < 1 {
+		return fmt.Errorf("invalid number of presents: %d", s.maxPresents)
+	}
+	return nil
+}
+
+// Tags returns the algorithms and techniques used by the solution.
+func (s *solver) Tags() []string {
+	return []string{"Sieve (divisor sum)"}
+}
+
+// Part1 returns the first house to get at least the target number of presents.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	maxVisits := (s.maxPresents + 10 - 1) / 10
+
+	sieve := make([]int, maxVisits+1)
+	for i := 1; i <= maxVisits; i++ {
+		for j := i; j <= maxVisits; j += i {
+			sieve[j] += i
+		}
+	}
+
+	// Find the first house with at least maxVisits visits.
+	for i := 1; i <= maxVisits; i++ {
+		if sieve[i] >= maxVisits {
+			return i, nil
+		}
+	}
+	return nil, errors.New("no house gets enough presents")
+}
+
+// Part2 returns the first house to get at least the target number of presents when each elf visits only 50 houses.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	maxVisits := (s.maxPresents + 11 - 1) / 11
+
+	sieve := make([]int, maxVisits+1)
+	for i := 1; i <= maxVisits; i++ {
+		for j := i; j <= min(maxVisits, i*50); j += i {
+			sieve[j] += i
+		}
+	}
+
+	// Find the first house with at least maxVisits visits.
+	for i := 1; i <= maxVisits; i++ {
+		if sieve[i] >= maxVisits {
+			return i, nil
+		}
+	}
+	return nil, errors.New("no house gets enough presents")
+}
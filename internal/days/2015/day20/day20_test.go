@@ -0,0 +1,64 @@
+package day20
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestPart1_Examples(t *testing.T) {
+	// House 4 gets 70 presents, house 6 gets 120, and house 8 gets 150.
+	tests := []struct {
+		presents int
+		expected int
+	}{
+		{10, 1},
+		{70, 4},
+		{120, 6},
+		{150, 8},
+	}
+	for _, tt := range tests {
+		s := &solver{maxPresents: tt.presents}
+		got, err := s.Part1(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.expected {
+			t.Errorf("%d: expected %d, got %v", tt.presents, tt.expected, got)
+		}
+	}
+}
+
+func TestPart2_Small(t *testing.T) {
+	// House 6 gets 11 * (1 + 2 + 3 + 6) = 132 presents.
+	s := &solver{maxPresents: 132}
+	got, err := s.Part2(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 6 {
+		t.Fatalf("expected 6, got %v", got)
+	}
+}
+
+func TestParse(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(setuptest.Input(t, "29000000\n")); err != nil {
+		t.Fatal(err)
+	}
+	if s.maxPresents != 29000000 {
+		t.Fatalf("expected 29000000, got %d", s.maxPresents)
+	}
+	if err := s.Parse(setuptest.Input(t, "many\n")); err == nil {
+		t.Fatal("expected error for a non-number")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, presents := range []int{0, -100} {
+		s := &solver{maxPresents: presents}
+		if err := s.Validate(); err == nil {
+			t.Errorf("%d: expected an error", presents)
+		}
+	}
+}
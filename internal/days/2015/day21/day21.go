@@ -0,0 +1,183 @@
+package day21
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"math"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 21, func() setup.Solver {
+		return &solver{playerHitPoints: 100}
+	})
+}
+
+type Item struct {
+	name   string
+	cost   int
+	damage int
+	armor  int
+}
+
+var weapons = []Item{
+	{"Dagger", 8, 4, 0},
+	{"Shortsword", 10, 5, 0},
+	{"Warhammer", 25, 6, 0},
+	{"Longsword", 40, 7, 0},
+	{"Greataxe", 74, 8, 0},
+}
+var armor = []Item{
+	{"None", 0, 0, 0},
+	{"Leather", 13, 0, 1},
+	{"Chainmail", 31, 0, 2},
+	{"Splintmail", 53, 0, 3},
+	{"Bandedmail", 75, 0, 4},
+	{"Platemail", 102, 0, 5},
+}
+
+var rings = []Item{
+	{"None", 0, 0, 0},
+	{"Damage +1", 25, 1, 0},
+	{"Damage +2", 50, 2, 0},
+	{"Damage +3", 100, 3, 0},
+	{"Defense +1", 20, 0, 1},
+	{"Defense +2", 40, 0, 2},
+	{"Defense +3", 80, 0, 3},
+}
+
+var maxConfigurations = len(weapons) * len(armor) * len(rings) * len(rings)
+
+func configuration(id int) (weaponId, armorId, ring1Id, ring2Id int) {
+	weaponId = id % len(weapons)
+	id /= len(weapons)
+
+	armorId = id % len(armor)
+	id /= len(armor)
+
+	ring1Id = id % len(rings)
+	id /= len(rings)
+
+	ring2Id = id % len(rings)
+	id /= len(rings)
+
+	return weaponId, armorId, ring1Id, ring2Id
+}
+
+type character struct {
+	hitPoints int
+	damage    int
+	armor     int
+}
+
+// battle returns true if the player wins the battle against the boss.
+func battle(player, boss character) bool {
+	bossHitPoints := boss.hitPoints
+	playerHitPoints := player.hitPoints
+
+	for playerHitPoints > 0 {
+		playerDamageDealt := player.damage - boss.armor
+		if playerDamageDealt < 1 {
+			playerDamageDealt = 1
+		}
+		bossHitPoints -= playerDamageDealt
+
+		if bossHitPoints <= 0 {
+			return true
+		}
+
+		bossDamageDealt := boss.damage - player.armor
+		if bossDamageDealt < 1 {
+			bossDamageDealt = 1
+		}
+		playerHitPoints -= bossDamageDealt
+	}
+
+	return false
+}
+
+type solver struct {
+	boss            character
+	playerHitPoints int
+}
+
+// Parse parses the stats of the boss.
+func (s *solver) Parse(path string) error {
+	lines, err := load.Lines(path)
+	if err != nil {
+		return err
+	}
+
+	formats := []string{"Hit Points: %d", "Damage: %d", "Armor: %d"}
+	stats := []*int{&s.boss.hitPoints, &s.boss.damage, &s.boss.armor}
+	if len(lines) != len(formats) {
+		return fmt.Errorf("expected %d stats, got %d lines", len(formats), len(lines))
+	}
+	for i, line := range lines {
+		if _, err := fmt.Sscanf(line, formats[i], stats[i]); err != nil {
+			return fmt.Errorf("invalid stat %q: %w", line, err)
+		}
+	}
+	return nil
+}
+
+// Flags defines flags that override the stats of the boss.
+func (s *solver) Flags(fs *flag.FlagSet) {
+	fs.IntVar(&s.boss.hitPoints, "boss-hp", s.boss.hitPoints, "Hit points of the boss (overrides the input)")
+	fs.IntVar(&s.boss.damage, "boss-damage", s.boss.damage, "Damage of the boss (overrides the input)")
+	fs.IntVar(&s.boss.armor, "boss-armor", s.boss.armor, "Armor of the boss (overrides the input)")
+}
+
+// Validate checks that the boss has hit points and that its damage and armor are not negative.
+func (s *solver) Validate() error {
+	if s.boss.hitPoints < 1 || s.boss.damage < 0 || s.boss.armor < 0 {
+		return fmt.Errorf("invalid stats of the boss: %+v", s.boss)
+	}
+	return nil
+}
+
+// outfit returns the cost of the given configuration and the player equipped with it.
+func (s *solver) outfit(id int) (int, character) {
+	weaponId, armorId, ring1Id, ring2Id := configuration(id)
+	cost := weapons[weaponId].cost + armor[armorId].cost + rings[ring1Id].cost + rings[ring2Id].cost
+	player := character{
+		hitPoints: s.playerHitPoints,
+		damage:    weapons[weaponId].damage + armor[armorId].damage + rings[ring1Id].damage + rings[ring2Id].damage,
+		armor:     weapons[weaponId].armor + armor[armorId].armor + rings[ring1Id].armor + rings[ring2Id].armor,
+	}
+	return cost, player
+}
+
+// Part1 returns the least amount of gold that can be spent and still win.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	minCost := math.MaxInt
+	for id := 0; id < maxConfigurations; id++ {
+		cost, player := s.outfit(id)
+		if cost < minCost && battle(player, s.boss) {
+			minCost = cost
+		}
+	}
+	if minCost == math.MaxInt {
+		return nil, errors.New("the boss cannot be beaten")
+	}
+	return minCost, nil
+}
+
+// Part2 returns the most amount of gold that can be spent and still lose.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	maxCost := math.MinInt
+	for id := 0; id < maxConfigurations; id++ {
+		cost, player := s.outfit(id)
+		if cost > maxCost && !battle(player, s.boss) {
+			maxCost = cost
+		}
+	}
+	if maxCost == math.MinInt {
+		return nil, errors.New("the boss cannot be lost to")
+	}
+	return maxCost, nil
+}
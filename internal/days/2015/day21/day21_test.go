@@ -0,0 +1,48 @@
+package day21
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestBattle_Example(t *testing.T) {
+	player := character{hitPoints: 8, damage: 5, armor: 5}
+	boss := character{hitPoints: 12, damage: 7, armor: 2}
+	if !battle(player, boss) {
+		t.Fatal("expected the player to win")
+	}
+}
+
+func TestBattle_Loses(t *testing.T) {
+	player := character{hitPoints: 8, damage: 5, armor: 5}
+	boss := character{hitPoints: 13, damage: 7, armor: 2}
+	if battle(player, boss) {
+		t.Fatal("expected the player to lose")
+	}
+}
+
+func TestConfiguration_Covers(t *testing.T) {
+	seen := make(map[[4]int]bool)
+	for id := 0; id < maxConfigurations; id++ {
+		w, a, r1, r2 := configuration(id)
+		seen[[4]int{w, a, r1, r2}] = true
+	}
+	if len(seen) != maxConfigurations {
+		t.Fatalf("expected %d distinct configurations, got %d", maxConfigurations, len(seen))
+	}
+}
+
+func TestParse(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(setuptest.Input(t, "Hit Points: 104\nDamage: 8\nArmor: 1\n")); err != nil {
+		t.Fatal(err)
+	}
+	expected := character{hitPoints: 104, damage: 8, armor: 1}
+	if s.boss != expected {
+		t.Fatalf("expected %+v, got %+v", expected, s.boss)
+	}
+	if err := s.Parse(setuptest.Input(t, "Hit Points: 104\nDamage: 8\n")); err == nil {
+		t.Fatal("expected error for a missing stat")
+	}
+}
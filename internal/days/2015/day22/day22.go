@@ -0,0 +1,307 @@
+package day22
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"math"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 22, func() setup.Solver {
+		return &solver{playerHitPoints: 50, playerMana: 500}
+	})
+}
+
+type State struct {
+	playerHitPoints int
+	playerMana      int
+	playerArmor     int
+	bossHitPoints   int
+	shieldTimer     int
+	poisonTimer     int
+	rechargeTimer   int
+}
+
+type Spell struct {
+	name string
+	cast func(state *State, spell Spell) int
+	cost int
+}
+
+var spells = []Spell{
+	{name: "Magic Missile", cast: magicMissile, cost: 53},
+	{name: "Drain", cast: drain, cost: 73},
+	{name: "Shield", cast: shield, cost: 113},
+	{name: "Poison", cast: poison, cost: 173},
+	{name: "Recharge", cast: recharge, cost: 229},
+}
+
+type CacheValue struct {
+	manaSpent int
+	playerWon bool
+}
+type Cache map[State]CacheValue
+
+// search holds what is shared by the turns of a search for the least mana.
+type search struct {
+	budget *setup.Budget
+	cache  Cache
+	part   int
+	log    *slog.Logger // Logger of the trace events, or nil if they are not logged
+}
+
+type solver struct {
+	bossHitPoints   int
+	bossDamage      int
+	playerHitPoints int
+	playerMana      int
+}
+
+// Parse parses the stats of the boss.
+func (s *solver) Parse(path string) error {
+	lines, err := load.Lines(path)
+	if err != nil {
+		return err
+	}
+
+	formats := []string{"Hit Points: %d", "Damage: %d"}
+	stats := []*int{&s.bossHitPoints, &s.bossDamage}
+	if len(lines) != len(formats) {
+		return fmt.Errorf("expected %d stats, got %d lines", len(formats), len(lines))
+	}
+	for i, line := range lines {
+		if _, err := fmt.Sscanf(line, formats[i], stats[i]); err != nil {
+			return fmt.Errorf("invalid stat %q: %w", line, err)
+		}
+	}
+	return nil
+}
+
+// Flags defines flags that override the stats of the boss.
+func (s *solver) Flags(fs *flag.FlagSet) {
+	fs.IntVar(&s.bossHitPoints, "boss-hp", s.bossHitPoints, "Hit points of the boss (overrides the input)")
+	fs.IntVar(&s.bossDamage, "boss-damage", s.bossDamage, "Damage of the boss (overrides the input)")
+}
+
+// Validate checks that the boss has hit points and that its damage is not negative.
+func (s *solver) Validate() error {
+	if s.bossHitPoints < 1 || s.bossDamage < 0 {
+		return fmt.Errorf("invalid stats of the boss: %d hit points and %d damage", s.bossHitPoints, s.bossDamage)
+	}
+	return nil
+}
+
+// leastMana returns the least amount of mana that can be spent and still win the given part.
+func (s *solver) leastMana(ctx context.Context, part int) (any, error) {
+	state := State{
+		playerHitPoints: s.playerHitPoints,
+		playerMana:      s.playerMana,
+		bossHitPoints:   s.bossHitPoints,
+	}
+
+	sr := &search{budget: setup.NewBudget(ctx), cache: Cache{}, part: part}
+	if log := setup.Logger(ctx); log.Enabled(ctx, setup.LevelTrace) {
+		sr.log = log
+	}
+	manaSpent, playerWon, err := s.nextTurn(sr, state, 1)
+	if err != nil {
+		return nil, err
+	}
+	setup.Logger(ctx).Debug("search finished", "states", sr.budget.States(), "cached", len(sr.cache))
+	if !playerWon {
+		return nil, errors.New("player lost")
+	}
+	return manaSpent, nil
+}
+
+// Tags returns the algorithms and techniques used by the solution.
+func (s *solver) Tags() []string {
+	return []string{"Memoization"}
+}
+
+// Part1 returns the least amount of mana that can be spent and still win.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	return s.leastMana(ctx, 1)
+}
+
+// Part2 returns the least amount of mana that can be spent and still win on hard difficulty.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	return s.leastMana(ctx, 2)
+}
+
+func (s *solver) nextTurn(sr *search, state State, round int) (int, bool, error) {
+	// Check if this state has already been computed
+	if cacheValue, found := sr.cache[state]; found {
+		return cacheValue.manaSpent, cacheValue.playerWon, nil
+	}
+	if err := sr.budget.Step(); err != nil {
+		return 0, false, err
+	}
+
+	startingState := state
+
+	// For part 2, deduct 1 hit point from the player at the start of each of their turns.
+	if sr.part == 2 {
+		state.playerHitPoints--
+		if state.playerHitPoints <= 0 {
+			sr.cache[startingState] = CacheValue{manaSpent: 0, playerWon: false}
+			return 0, false, nil
+		}
+	}
+
+	sr.trace("player's turn", round, &state)
+	// Apply effects at the start of each turn
+	applyEffects(&state)
+	sr.trace("after effects", round, &state)
+
+	// Check if the boss is dead after applying effects. Effects cost no mana.
+	if state.bossHitPoints <= 0 {
+		sr.trace("boss is dead from effects", round, &state)
+		sr.cache[startingState] = CacheValue{manaSpent: 0, playerWon: true}
+		return 0, true, nil
+	}
+
+	// if the player doesn't have enough mana to cast any spell, they lose
+	if state.playerMana < 53 {
+		sr.trace("player is out of mana", round, &state)
+		sr.cache[startingState] = CacheValue{manaSpent: 0, playerWon: false}
+		return 0, false, nil
+	}
+
+	// Find the minimum mana spent to win from this state
+	minManaSpent := math.MaxInt
+	playerWon := false // Assume the player hasn't won yet
+
+	for _, spell := range spells {
+		if state.playerMana >= spell.cost {
+			nextState := state
+			manaSpent := spell.cast(&nextState, spell)
+			if manaSpent == 0 {
+				continue // If the spell couldn't be cast, skip to the next spell
+			}
+			sr.trace(spell.name, round, &nextState)
+			if nextState.bossHitPoints > 0 {
+				s.bossAttack(sr, &nextState, round)
+				if nextState.bossHitPoints > 0 {
+					if nextState.playerHitPoints <= 0 {
+						sr.trace("player is dead", round, &nextState)
+						continue // If the player lost, skip to the next spell
+					}
+					manaSpentNext, playerWonNext, err := s.nextTurn(sr, nextState, round+1) // Recursively continue to the next turn
+					if err != nil {
+						return 0, false, err
+					}
+					if !playerWonNext {
+						continue // If the player lost in the end, skip to the next spell
+					}
+					manaSpent += manaSpentNext
+					playerWon = playerWonNext
+				} else {
+					sr.trace("boss is dead", round, &nextState)
+					playerWon = true
+				}
+			} else {
+				sr.trace("boss is dead", round, &nextState)
+				playerWon = true
+			}
+
+			minManaSpent = min(minManaSpent, manaSpent)
+		}
+	}
+	if playerWon && sr.log != nil {
+		sr.log.Log(context.Background(), setup.LevelTrace, "mana spent", "round", round, "mana", minManaSpent)
+	}
+
+	// Cache the result for this state
+	sr.cache[startingState] = CacheValue{manaSpent: minManaSpent, playerWon: playerWon}
+	return minManaSpent, playerWon, nil
+}
+
+func applyEffects(state *State) {
+	if state.shieldTimer > 0 {
+		state.playerArmor = 7
+		state.shieldTimer--
+	} else {
+		state.playerArmor = 0
+	}
+
+	if state.poisonTimer > 0 {
+		state.bossHitPoints -= 3
+		state.poisonTimer--
+	}
+
+	if state.rechargeTimer > 0 {
+		state.playerMana += 101
+		state.rechargeTimer--
+	}
+}
+
+// trace logs an event of the search in the given round at the trace level, if trace events are logged. The state is
+// passed by pointer so that it is copied only if the event is logged.
+func (sr *search) trace(msg string, round int, state *State) {
+	if sr.log != nil {
+		sr.log.Log(context.Background(), setup.LevelTrace, msg, "round", round, "state", *state)
+	}
+}
+
+func magicMissile(state *State, spell Spell) int {
+	state.playerMana -= spell.cost
+	state.bossHitPoints -= 4
+	return spell.cost
+}
+
+func drain(state *State, spell Spell) int {
+	state.playerMana -= spell.cost
+	state.bossHitPoints -= 2
+	state.playerHitPoints += 2
+	return spell.cost
+}
+
+func shield(state *State, spell Spell) int {
+	if state.shieldTimer > 0 {
+		return 0 // Can't cast Shield if it's already active
+	}
+	state.playerMana -= spell.cost
+	state.shieldTimer = 6
+	return spell.cost
+}
+
+func poison(state *State, spell Spell) int {
+	if state.poisonTimer > 0 {
+		return 0 // Can't cast Poison if it's already active
+	}
+	state.playerMana -= spell.cost
+	state.poisonTimer = 6
+	return spell.cost
+}
+
+func recharge(state *State, spell Spell) int {
+	if state.rechargeTimer > 0 {
+		return 0 // Can't cast Recharge if it's already active
+	}
+	state.playerMana -= spell.cost
+	state.rechargeTimer = 5
+	return spell.cost
+}
+
+func (s *solver) bossAttack(sr *search, state *State, round int) {
+	sr.trace("boss's turn", round, state)
+	// Apply effects at the start of each half turn
+	applyEffects(state)
+	sr.trace("after effects", round, state)
+
+	if state.bossHitPoints > 0 {
+		damage := s.bossDamage - state.playerArmor
+		if damage < 1 {
+			damage = 1
+		}
+		state.playerHitPoints -= damage
+	}
+	sr.trace("after boss attack", round, state)
+}
@@ -0,0 +1,83 @@
+package day22
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestPart1_Example(t *testing.T) {
+	// Poison followed by Magic Missile
+	s := &solver{bossHitPoints: 13, bossDamage: 8, playerHitPoints: 10, playerMana: 250}
+	got, err := s.Part1(t.Context())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 226 {
+		t.Fatalf("expected 226, got %v", got)
+	}
+}
+
+func TestPart1_PlayerLoses(t *testing.T) {
+	s := &solver{bossHitPoints: 100, bossDamage: 50, playerHitPoints: 10, playerMana: 250}
+	if _, err := s.Part1(t.Context()); err == nil {
+		t.Fatal("expected the player to lose")
+	}
+}
+
+func TestApplyEffects(t *testing.T) {
+	state := State{bossHitPoints: 10, playerMana: 0, shieldTimer: 1, poisonTimer: 2, rechargeTimer: 3}
+	applyEffects(&state)
+	if state.playerArmor != 7 || state.bossHitPoints != 7 || state.playerMana != 101 {
+		t.Fatalf("unexpected state after effects: %+v", state)
+	}
+	if state.shieldTimer != 0 || state.poisonTimer != 1 || state.rechargeTimer != 2 {
+		t.Fatalf("unexpected timers after effects: %+v", state)
+	}
+	applyEffects(&state)
+	if state.playerArmor != 0 {
+		t.Fatalf("expected shield to wear off, got armor %d", state.playerArmor)
+	}
+}
+
+func TestParse(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(setuptest.Input(t, "Hit Points: 51\nDamage: 9\n")); err != nil {
+		t.Fatal(err)
+	}
+	if s.bossHitPoints != 51 || s.bossDamage != 9 {
+		t.Fatalf("expected 51 and 9, got %d and %d", s.bossHitPoints, s.bossDamage)
+	}
+	if err := s.Parse(setuptest.Input(t, "Damage: 9\nHit Points: 51\n")); err == nil {
+		t.Fatal("expected error for stats out of order")
+	}
+}
+
+func TestPart2_Timeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 0)
+	defer cancel()
+	s := &solver{bossHitPoints: 51, bossDamage: 9, playerHitPoints: 50, playerMana: 500}
+	if _, err := s.Part2(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+}
+
+func TestPart1_Trace(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: setup.LevelTrace}))
+	s := &solver{bossHitPoints: 13, bossDamage: 8, playerHitPoints: 10, playerMana: 250}
+	if _, err := s.Part1(setup.WithLogger(t.Context(), log)); err != nil {
+		t.Fatal(err)
+	}
+	for _, event := range []string{`msg="player's turn"`, `msg=Poison`, `msg="boss is dead`, `msg="search finished"`} {
+		if !strings.Contains(buf.String(), event) {
+			t.Errorf("expected event %s in the trace", event)
+		}
+	}
+}
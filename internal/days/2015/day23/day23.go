@@ -0,0 +1,175 @@
+package day23
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 23, func() setup.Solver { return &solver{} })
+}
+
+type instruction struct {
+	opcode string
+	reg    int
+	offset int
+}
+
+type cpu struct {
+	registers [2]int
+	pc        int
+}
+
+func (c *cpu) hlf(r *int) {
+	*r /= 2
+	c.pc++
+}
+
+func (c *cpu) tpl(r *int) {
+	*r *= 3
+	c.pc++
+}
+
+func (c *cpu) inc(r *int) {
+	*r++
+	c.pc++
+}
+
+func (c *cpu) jmp(offset int) {
+	c.pc += offset
+}
+
+func (c *cpu) jie(r *int, offset int) {
+	if *r%2 == 0 {
+		c.pc += offset
+	} else {
+		c.pc++
+	}
+}
+
+func (c *cpu) jio(r *int, offset int) {
+	if *r == 1 {
+		c.pc += offset
+	} else {
+		c.pc++
+	}
+}
+
+func (c *cpu) execute(inst []instruction) {
+	for c.pc >= 0 && c.pc < len(inst) {
+		i := inst[c.pc]
+		switch i.opcode {
+		case "hlf":
+			c.hlf(&c.registers[i.reg])
+		case "tpl":
+			c.tpl(&c.registers[i.reg])
+		case "inc":
+			c.inc(&c.registers[i.reg])
+		case "jmp":
+			c.jmp(i.offset)
+		case "jie":
+			c.jie(&c.registers[i.reg], i.offset)
+		case "jio":
+			c.jio(&c.registers[i.reg], i.offset)
+		}
+	}
+}
+
+type solver struct {
+	program []instruction
+}
+
+// Parse parses the program.
+func (s *solver) Parse(path string) error {
+	lines, err := load.Numbered(path)
+	if err != nil {
+		return err
+	}
+	s.program, err = parseInstructions(lines)
+	return err
+}
+
+// run executes the program with register a set to the given value and returns the value of register b.
+func (s *solver) run(a int) int {
+	c := &cpu{}
+	c.registers[0] = a
+	c.execute(s.program)
+	return c.registers[1]
+}
+
+// Part1 returns the value of register b when the program ends.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	return s.run(0), nil
+}
+
+// Part2 returns the value of register b when the program ends if register a starts as 1.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	return s.run(1), nil
+}
+
+func parseInstructions(lines []load.Line) ([]instruction, error) {
+	instructions := make([]instruction, len(lines))
+	for i, line := range lines {
+		var err error
+		if instructions[i], err = parseInstruction(line); err != nil {
+			return nil, err
+		}
+	}
+	return instructions, nil
+}
+
+// parseInstruction returns the instruction in the line, or a ParseError at the opcode or operand that is invalid.
+func parseInstruction(line load.Line) (instruction, error) {
+
+	// hlf r sets register r to half its current value, then continues with the next instruction.
+	// tpl r sets register r to triple its current value, then continues with the next instruction.
+	// inc r increments register r, adding 1 to it, then continues with the next instruction.
+	// jmp offset is a jump; it continues with the instruction offset away relative to itself.
+	// jie r, offset is like jmp, but only jumps if register r is even ("jump if even").
+	// jio r, offset is like jmp, but only jumps if register r is 1 ("jump if one", not odd).
+
+	var i instruction
+	opcode, operands, _ := strings.Cut(line.Text, " ")
+	column := len(opcode) + 2 // Column of the first operand
+	i.opcode = opcode
+	var err error
+	switch i.opcode {
+	case "hlf", "tpl", "inc":
+		i.reg, err = parseRegister(line, column, operands)
+	case "jmp":
+		i.offset, err = parseOffset(line, column, operands)
+	case "jie", "jio":
+		reg, offset, _ := strings.Cut(operands, ", ")
+		if i.reg, err = parseRegister(line, column, reg); err == nil {
+			i.offset, err = parseOffset(line, column+len(reg)+2, offset)
+		}
+	default:
+		err = line.Errorf(1, opcode, "unknown instruction")
+	}
+	return i, err
+}
+
+// parseRegister returns the index of the register named by the text at the column of the line.
+func parseRegister(line load.Line, column int, text string) (int, error) {
+	switch text {
+	case "a":
+		return 0, nil
+	case "b":
+		return 1, nil
+	case "":
+		return 0, line.Errorf(len(line.Text)+1, text, "missing register")
+	default:
+		return 0, line.Errorf(column, text, "invalid register, must be a or b")
+	}
+}
+
+// parseOffset returns the jump offset in the text at the column of the line.
+func parseOffset(line load.Line, column int, text string) (int, error) {
+	if text == "" {
+		return 0, line.Errorf(len(line.Text)+1, text, "missing offset")
+	}
+	return line.Atoi(column, text)
+}
@@ -0,0 +1,72 @@
+package day23
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestExecute_Example(t *testing.T) {
+	program, err := parseInstructions([]load.Line{{Text: "inc a"}, {Text: "jio a, +2"}, {Text: "tpl a"}, {Text: "inc a"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &cpu{}
+	c.execute(program)
+	if c.registers[0] != 2 {
+		t.Fatalf("expected register a to be 2, got %d", c.registers[0])
+	}
+}
+
+func TestParts(t *testing.T) {
+	// b is incremented once, then tripled only if a starts as 1
+	input := "inc b\njio a, +2\njmp +2\ntpl b\n"
+	if got := setuptest.Solve(t, &solver{}, input, 1); got != 1 {
+		t.Errorf("part 1: expected 1, got %v", got)
+	}
+	if got := setuptest.Solve(t, &solver{}, input, 2); got != 3 {
+		t.Errorf("part 2: expected 3, got %v", got)
+	}
+}
+
+func TestParseInstruction(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected instruction
+	}{
+		{"hlf a", instruction{opcode: "hlf", reg: 0}},
+		{"inc b", instruction{opcode: "inc", reg: 1}},
+		{"jmp -7", instruction{opcode: "jmp", offset: -7}},
+		{"jie b, +4", instruction{opcode: "jie", reg: 1, offset: 4}},
+	}
+	for _, tt := range tests {
+		if got, err := parseInstruction(load.Line{Text: tt.line}); err != nil || got != tt.expected {
+			t.Errorf("%s: expected %+v, got %+v, %v", tt.line, tt.expected, got, err)
+		}
+	}
+}
+
+func TestParseInstruction_Errors(t *testing.T) {
+	tests := []struct {
+		line   string
+		column int
+		text   string
+	}{
+		{"nop a", 1, "nop"},
+		{"inc c", 5, "c"},
+		{"jmp +x", 5, "+x"},
+		{"jmp", 4, ""},
+		{"tpl", 4, ""},
+		{"jie a, 4x", 8, "4x"},
+		{"jio a", 6, ""},
+	}
+	for _, tt := range tests {
+		_, err := parseInstruction(load.Line{Number: 3, Text: tt.line})
+		var pe *load.ParseError
+		if !errors.As(err, &pe) || pe.Line != 3 || pe.Column != tt.column || pe.Text != tt.text {
+			t.Errorf("%s: expected an error at column %d at %q, got %v", tt.line, tt.column, tt.text, err)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package day24
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+	"github.com/jambolo/advent-of-code-2015/internal/utils"
+)
+
+func init() {
+	setup.Register(2015, 24, func() setup.Solver { return &solver{} })
+}
+
+type solver struct {
+	packages []int
+}
+
+// Parse loads the weights of the packages.
+func (s *solver) Parse(path string) error {
+	var err error
+	s.packages, err = load.Ints[int](path)
+	return err
+}
+
+// minEntanglement returns the quantum entanglement of the best first group when the packages are split into the
+// given number of groups.
+func (s *solver) minEntanglement(groupCount int) (any, error) {
+	groupWeight := utils.SliceSum(s.packages) / groupCount
+
+	groups := groupRecursive(s.packages, groupWeight)
+	if len(groups) == 0 {
+		return nil, errors.New("the packages cannot be split into groups of equal weight")
+	}
+
+	minGroupSize := len(groups[0])
+	minEntanglement := utils.SliceProduct(utils.Gather(groups[0], s.packages))
+
+	for i := 1; i < len(groups); i++ {
+		groupSize := len(groups[i])
+		if groupSize <= minGroupSize {
+			entanglement := utils.SliceProduct(utils.Gather(groups[i], s.packages))
+			if groupSize < minGroupSize {
+				minGroupSize = groupSize
+				minEntanglement = entanglement
+			} else if entanglement < minEntanglement {
+				minEntanglement = entanglement
+			}
+		}
+	}
+	return minEntanglement, nil
+}
+
+// Part1 returns the quantum entanglement of the best first group when the packages are split into three groups.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	return s.minEntanglement(3)
+}
+
+// Part2 returns the quantum entanglement of the best first group when the packages are split into four groups.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	return s.minEntanglement(4)
+}
+
+func groupRecursive(packages []int, weight int) [][]int {
+	group := make([][]int, 0)
+	for i := range packages {
+		if packages[i] == weight {
+			group = append(group, []int{i})
+		} else if packages[i] < weight {
+			subgroups := groupRecursive(packages[i+1:], weight-packages[i])
+			for j := range subgroups {
+				for k := range subgroups[j] {
+					subgroups[j][k] += i + 1
+				}
+				subgroups[j] = append(subgroups[j], i)
+				group = append(group, subgroups[j])
+			}
+		}
+	}
+	return group
+}
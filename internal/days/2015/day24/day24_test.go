@@ -0,0 +1,28 @@
+package day24
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+const example = "1\n2\n3\n4\n5\n7\n8\n9\n10\n11\n"
+
+func TestPart1_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{}, example, 1); got != 99 {
+		t.Fatalf("expected 99, got %v", got)
+	}
+}
+
+func TestPart2_Example(t *testing.T) {
+	if got := setuptest.Solve(t, &solver{}, example, 2); got != 44 {
+		t.Fatalf("expected 44, got %v", got)
+	}
+}
+
+func TestParse_InvalidWeight(t *testing.T) {
+	s := &solver{}
+	if err := s.Parse(setuptest.Input(t, "1\nx\n")); err == nil {
+		t.Fatal("expected error for invalid weight")
+	}
+}
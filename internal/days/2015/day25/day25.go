@@ -0,0 +1,82 @@
+package day25
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func init() {
+	setup.Register(2015, 25, func() setup.Solver { return &solver{} })
+}
+
+var re = regexp.MustCompile(`row (\d+), column (\d+)`)
+
+type solver struct {
+	row    int
+	column int
+}
+
+// Parse parses the row and column of the code.
+func (s *solver) Parse(path string) error {
+	input, err := load.All(path)
+	if err != nil {
+		return err
+	}
+	m := re.FindStringSubmatch(input)
+	if m == nil {
+		return fmt.Errorf("row and column not found in %q", input)
+	}
+	if s.row, err = strconv.Atoi(m[1]); err != nil {
+		return fmt.Errorf("invalid row: %w", err)
+	}
+	if s.column, err = strconv.Atoi(m[2]); err != nil {
+		return fmt.Errorf("invalid column: %w", err)
+	}
+	return nil
+}
+
+// Flags defines flags that override the row and column of the code.
+func (s *solver) Flags(fs *flag.FlagSet) {
+	fs.IntVar(&s.row, "row", s.row, "Row of the code (overrides the input)")
+	fs.IntVar(&s.column, "column", s.column, "Column of the code (overrides the input)")
+}
+
+// Validate checks that the row and column are in the grid, which starts at row 1, column 1.
+func (s *solver) Validate() error {
+	if s.row < 1 || s.column < 1 {
+		return fmt.Errorf("invalid position: row %d, column %d", s.row, s.column)
+	}
+	return nil
+}
+
+// Tags returns the algorithms and techniques used by the solution.
+func (s *solver) Tags() []string {
+	return []string{"Triangular number indexing"}
+}
+
+// Part1 returns the code at the given row and column.
+func (s *solver) Part1(ctx context.Context) (any, error) {
+	// The index of the code is T_n + c, where T_n is the nth triangular number and n is (r + c - 2)
+	n := s.row + s.column - 2
+	t := n * (n + 1) / 2
+	index := t + s.column
+
+	x := 20151125
+	a := 252533
+	m := 33554393
+	for i := 1; i < index; i++ {
+		x = (x * a) % m
+	}
+	return x, nil
+}
+
+// Part2 returns ErrNoPart because there is no part 2 on day 25.
+func (s *solver) Part2(ctx context.Context) (any, error) {
+	return nil, setup.ErrNoPart
+}
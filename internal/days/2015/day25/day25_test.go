@@ -0,0 +1,69 @@
+package day25
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+	"github.com/jambolo/advent-of-code-2015/internal/setup/setuptest"
+)
+
+func TestPart1_Examples(t *testing.T) {
+	tests := []struct {
+		row, column int
+		expected    int
+	}{
+		{1, 1, 20151125},
+		{2, 1, 31916031},
+		{1, 2, 18749137},
+		{4, 2, 32451966},
+		{6, 6, 27995004},
+	}
+	for _, tt := range tests {
+		s := &solver{row: tt.row, column: tt.column}
+		got, err := s.Part1(t.Context())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.expected {
+			t.Errorf("row %d, column %d: expected %d, got %v", tt.row, tt.column, tt.expected, got)
+		}
+	}
+}
+
+func TestPart2_NoPart(t *testing.T) {
+	s := &solver{}
+	if _, err := s.Part2(t.Context()); !errors.Is(err, setup.ErrNoPart) {
+		t.Fatalf("expected ErrNoPart, got %v", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	s := &solver{}
+	input := "To continue, please consult the code grid in the manual.  Enter the code at row 3010, column 3019.\n"
+	if err := s.Parse(setuptest.Input(t, input)); err != nil {
+		t.Fatal(err)
+	}
+	if s.row != 3010 || s.column != 3019 {
+		t.Fatalf("expected row 3010, column 3019, got row %d, column %d", s.row, s.column)
+	}
+	if err := s.Parse(setuptest.Input(t, "Enter the code.\n")); err == nil {
+		t.Fatal("expected error for a missing row and column")
+	}
+	if err := s.Parse(setuptest.Input(t, "Enter the code at row 0, column 3.\n")); err != nil || s.Validate() == nil {
+		t.Fatalf("expected row 0 to be parsed and then rejected by Validate, got %v", err)
+	}
+	if err := s.Parse(setuptest.Input(t, "Enter the code at row 99999999999999999999, column 3.\n")); err == nil {
+		t.Fatal("expected error for a row out of range")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct{ row, column int }{{0, 0}, {1, 0}, {-1, 5}}
+	for _, tt := range tests {
+		s := &solver{row: tt.row, column: tt.column}
+		if err := s.Validate(); err == nil {
+			t.Errorf("row %d, column %d: expected an error", tt.row, tt.column)
+		}
+	}
+}
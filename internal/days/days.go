@@ -0,0 +1,30 @@
+// Package days imports the solution for every day so that each one registers itself with setup.
+package days
+
+import (
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day01"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day02"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day03"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day04"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day05"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day06"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day07"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day08"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day09"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day10"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day11"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day12"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day13"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day14"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day15"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day16"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day17"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day18"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day19"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day20"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day21"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day22"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day23"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day24"
+	_ "github.com/jambolo/advent-of-code-2015/internal/days/2015/day25"
+)
@@ -0,0 +1,105 @@
+package load
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseError is an error in the input at a position in a file, which is reported like an error of a compiler, as in
+// "input.txt:3:5: invalid offset at "+x"".
+type ParseError struct {
+	Path   string // Path of the file, which is Stdin for the standard input, or empty if unknown
+	Line   int    // Number of the line, from 1, or 0 if unknown
+	Column int    // Byte offset of the error in the line, from 1, or 0 if unknown
+	Text   string // Text at the error, or empty if the error is at the end of the line
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	var b strings.Builder
+	switch e.Path {
+	case "":
+	case Stdin:
+		b.WriteString("<stdin>:")
+	default:
+		b.WriteString(e.Path + ":")
+	}
+	if e.Line > 0 {
+		b.WriteString(strconv.Itoa(e.Line) + ":")
+	}
+	if e.Column > 0 {
+		b.WriteString(strconv.Itoa(e.Column) + ":")
+	}
+	if b.Len() > 0 {
+		b.WriteString(" ")
+	}
+	b.WriteString(e.Err.Error())
+	if e.Text != "" {
+		fmt.Fprintf(&b, " at %q", e.Text)
+	}
+	return b.String()
+}
+
+func (e *ParseError) Unwrap() error { return e.Err }
+
+// Line is a line of a file, which locates the errors found in it.
+type Line struct {
+	Path   string
+	Number int // Number of the line, from 1
+	Text   string
+}
+
+// Numbered reads all lines from the provided file path, like Lines, with their numbers.
+func Numbered(path string) ([]Line, error) {
+	var r LineReader
+	var lines []Line
+	for n, text := range r.Lines(path) {
+		lines = append(lines, Line{Path: path, Number: n, Text: text})
+	}
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return []Line{{Path: path, Number: 1}}, nil
+	}
+	return lines, nil
+}
+
+// Errorf returns the ParseError of the text at the column of the line with the error formatted from format and args.
+func (l Line) Errorf(column int, text string, format string, args ...any) *ParseError {
+	return &ParseError{Path: l.Path, Line: l.Number, Column: column, Text: text, Err: fmt.Errorf(format, args...)}
+}
+
+// At returns err as a ParseError at the column of the line. If err is a ParseError of the text starting at the column,
+// such as one returned by Pattern.Parse for a part of the line, it is moved to its position in the line.
+func (l Line) At(column int, err error) *ParseError {
+	var pe *ParseError
+	if errors.As(err, &pe) {
+		return &ParseError{Path: l.Path, Line: l.Number, Column: column + max(pe.Column, 1) - 1, Text: pe.Text, Err: pe.Err}
+	}
+	return &ParseError{Path: l.Path, Line: l.Number, Column: column, Err: err}
+}
+
+// Atoi returns the integer in the text at the column of the line, or a ParseError if it is not an integer.
+func (l Line) Atoi(column int, text string) (int, error) {
+	return parseAt[int](l, column, text)
+}
+
+// Fields splits the line around runs of spaces, like strings.Fields, and returns the fields with their columns.
+func (l Line) Fields() (fields []string, columns []int) {
+	start := -1 // Offset of the field being read, or -1 if there is none
+	for i, c := range l.Text + " " {
+		switch {
+		case !unicode.IsSpace(c) && start < 0:
+			start = i
+		case unicode.IsSpace(c) && start >= 0:
+			fields = append(fields, l.Text[start:i])
+			columns = append(columns, start+1)
+			start = -1
+		}
+	}
+	return fields, columns
+}
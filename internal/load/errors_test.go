@@ -0,0 +1,74 @@
+package load
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestParseError_Error(t *testing.T) {
+	tests := []struct {
+		err      *ParseError
+		expected string
+	}{
+		{&ParseError{Path: "in.txt", Line: 3, Column: 5, Text: "+x", Err: errors.New("invalid offset")}, `in.txt:3:5: invalid offset at "+x"`},
+		{&ParseError{Path: "in.txt", Line: 3, Err: errors.New("empty")}, `in.txt:3: empty`},
+		{&ParseError{Path: Stdin, Line: 1, Column: 1, Err: errors.New("bad")}, `<stdin>:1:1: bad`},
+		{&ParseError{Column: 2, Text: "b", Err: errors.New("bad")}, `2: bad at "b"`},
+		{&ParseError{Err: errors.New("bad")}, `bad`},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.expected {
+			t.Errorf("expected %q, got %q", tt.expected, got)
+		}
+	}
+}
+
+func TestLine_Atoi(t *testing.T) {
+	l := Line{Path: "in.txt", Number: 4, Text: "jmp +x"}
+	if n, err := (Line{Text: "jmp -7"}).Atoi(5, "-7"); err != nil || n != -7 {
+		t.Errorf("expected -7, got %d, %v", n, err)
+	}
+	_, err := l.Atoi(5, "+x")
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Path != "in.txt" || pe.Line != 4 || pe.Column != 5 || pe.Text != "+x" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected the error to wrap strconv.ErrSyntax, got %v", err)
+	}
+}
+
+func TestLine_At(t *testing.T) {
+	l := Line{Path: "in.txt", Number: 2, Text: "Sue 1: cars: x"}
+	inner := &ParseError{Column: 7, Text: "x", Err: errors.New("bad value")}
+	if err := l.At(8, inner); err.Column != 14 || err.Line != 2 || err.Path != "in.txt" || err.Text != "x" {
+		t.Errorf("unexpected error %+v", err)
+	}
+	plain := errors.New("bad")
+	if err := l.At(3, plain); err.Column != 3 || !errors.Is(err, plain) {
+		t.Errorf("unexpected error %+v", err)
+	}
+}
+
+func TestLine_Fields(t *testing.T) {
+	fields, columns := Line{Text: "  1 2\t  280 "}.Fields()
+	if !slices.Equal(fields, []string{"1", "2", "280"}) || !slices.Equal(columns, []int{3, 5, 9}) {
+		t.Errorf("unexpected fields %q at %v", fields, columns)
+	}
+	if fields, _ := (Line{}).Fields(); len(fields) != 0 {
+		t.Errorf("expected no fields, got %q", fields)
+	}
+}
+
+func TestNumbered(t *testing.T) {
+	path := tempFile(t, "a\nb\n")
+	lines, err := Numbered(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 2 || lines[1] != (Line{Path: path, Number: 2, Text: "b"}) {
+		t.Errorf("unexpected lines %+v", lines)
+	}
+}
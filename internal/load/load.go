@@ -2,44 +2,85 @@ package load
 
 import (
 	"encoding/json"
+	"io"
 	"os"
 	"strings"
+	"sync"
 )
 
-// Lines reads all lines from the provided file path.
+// Stdin is the path that selects the standard input instead of a file.
+const Stdin = "-"
+
+// cachedReader reads the content of a reader only once, so that every reader of Stdin sees the same content.
+type cachedReader struct {
+	once sync.Once
+	r    io.Reader
+	data []byte
+	err  error
+}
+
+// read returns the content of the reader, reading it on the first call.
+func (c *cachedReader) read() ([]byte, error) {
+	c.once.Do(func() {
+		c.data, c.err = io.ReadAll(c.r)
+	})
+	return c.data, c.err
+}
+
+var stdin = &cachedReader{r: os.Stdin}
+
+// Read reads the entire content of the provided file path, or of the standard input if the path is Stdin.
+func Read(path string) ([]byte, error) {
+	if path != Stdin {
+		return os.ReadFile(path)
+	}
+	return stdin.read()
+}
+
+// Lines reads all lines from the provided file path, as read by a LineReader. An empty file has a single empty line.
 func Lines(path string) ([]string, error) {
-	data, err := os.ReadFile(path)
-	if err != nil {
+	var r LineReader
+	var lines []string
+	for _, line := range r.Lines(path) {
+		lines = append(lines, line)
+	}
+	if err := r.Err(); err != nil {
 		return nil, err
 	}
-	return strings.Split(strings.TrimRight(string(data), "\n"), "\n"), nil
+	if len(lines) == 0 {
+		return []string{""}, nil
+	}
+	return lines, nil
 }
 
 // All reads the entire content of the provided file path as a single string.
 func All(path string) (string, error) {
-	data, err := os.ReadFile(path)
+	data, err := Read(path)
 	if err != nil {
 		return "", err
 	}
 	return strings.TrimRight(string(data), "\n"), nil
 }
 
-// Map reads the content of the provided file path and constructs a 2D map of bytes.
+// Map reads the lines of the provided file path, as read by a LineReader, and constructs a 2D map of bytes.
 func Map(path string) ([][]byte, error) {
-	lines, err := Lines(path)
-	if err != nil {
+	var r LineReader
+	var result [][]byte
+	for _, line := range r.Lines(path) {
+		result = append(result, []byte(line))
+	}
+	if err := r.Err(); err != nil {
 		return nil, err
 	}
-	result := make([][]byte, len(lines))
-	for i, line := range lines {
-		result[i] = []byte(line)
+	if len(result) == 0 {
+		return [][]byte{{}}, nil
 	}
 	return result, nil
 }
 
 // Json reads the content of the provided file path and unmarshals it into the provided variable.
 func Json(path string, v any) error {
-	data, err := os.ReadFile(path)
+	data, err := Read(path)
 	if err != nil {
 		return err
 	}
@@ -3,6 +3,7 @@ package load
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -233,3 +234,36 @@ func TestJson_Unreadable(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+// Stdin tests
+
+func withStdin(t *testing.T, content string) {
+	t.Helper()
+	saved := stdin
+	stdin = &cachedReader{r: strings.NewReader(content)}
+	t.Cleanup(func() { stdin = saved })
+}
+
+func TestLines_Stdin(t *testing.T) {
+	withStdin(t, "aaa\nbbb\n")
+	lines, err := Lines(Stdin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 2 || lines[0] != "aaa" || lines[1] != "bbb" {
+		t.Fatalf("unexpected lines: %q", lines)
+	}
+}
+
+func TestRead_StdinReadOnce(t *testing.T) {
+	withStdin(t, "hello\n")
+	for range 2 {
+		data, err := Read(Stdin)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "hello\n" {
+			t.Fatalf("expected %q, got %q", "hello\n", data)
+		}
+	}
+}
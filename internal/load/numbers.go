@@ -0,0 +1,122 @@
+package load
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Integer is an integer type read by Ints and Split.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Ints reads the lines of the provided file path, each of which is a single integer of type T with optional spaces
+// around it, and returns the integers. A line that is not an integer is a ParseError.
+func Ints[T Integer](path string) ([]T, error) {
+	var r LineReader
+	var result []T
+	for n, text := range r.Lines(path) {
+		line := Line{Path: path, Number: n, Text: text}
+		trimmed := strings.TrimLeft(text, " ")
+		value, err := parseAt[T](line, len(text)-len(trimmed)+1, strings.TrimRight(trimmed, " "))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+var intPattern = regexp.MustCompile(`[-+]?\d+`)
+
+// IntFields reads the lines of the provided file path and returns the integers in each of them, in order, ignoring
+// any other text. A sign is part of the integer unless it follows a digit, so "x=5, y=-3..+2" has the integers 5, -3,
+// and 2, and the range "1-3" has 1 and 3. An integer that does not fit in an int is a ParseError.
+func IntFields(path string) ([][]int, error) {
+	var r LineReader
+	var result [][]int
+	for n, text := range r.Lines(path) {
+		line := Line{Path: path, Number: n, Text: text}
+		fields := []int{}
+		for _, loc := range intPattern.FindAllStringIndex(text, -1) {
+			// RE2 has no lookbehind, so a sign that follows a digit, as in a range, is dropped here.
+			if loc[0] > 0 && (text[loc[0]] == '-' || text[loc[0]] == '+') && isDigit(text[loc[0]-1]) {
+				loc[0]++
+			}
+			value, err := line.Atoi(loc[0]+1, text[loc[0]:loc[1]])
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, value)
+		}
+		result = append(result, fields)
+	}
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// isDigit returns true if c is an ASCII digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// Split reads the content of the provided file path as a list of integers of type T separated by any of the
+// characters in separators or by line breaks, such as "3,4,1,5" with the separators ",". Empty fields are skipped, so
+// the separators ", " read "3, 4, 1, 5". A field that is not an integer is a ParseError.
+func Split[T Integer](path string, separators string) ([]T, error) {
+	var r LineReader
+	var result []T
+	for n, text := range r.Lines(path) {
+		line := Line{Path: path, Number: n, Text: text}
+		start := 0
+		for i, c := range text + "\n" {
+			if c != '\n' && !strings.ContainsRune(separators, c) {
+				continue
+			}
+			if i > start {
+				value, err := parseAt[T](line, start+1, text[start:i])
+				if err != nil {
+					return nil, err
+				}
+				result = append(result, value)
+			}
+			start = i + utf8.RuneLen(c)
+		}
+	}
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// parseAt returns the integer of type T in the text at the column of the line, or a ParseError if it is not one.
+func parseAt[T Integer](line Line, column int, text string) (T, error) {
+	var zero T
+	bits := reflect.TypeFor[T]().Bits()
+	if text == "" {
+		return zero, line.Errorf(column, text, "missing integer")
+	}
+	var value T
+	var err error
+	if zero-1 < zero {
+		var n int64
+		n, err = strconv.ParseInt(text, 10, bits)
+		value = T(n)
+	} else {
+		var n uint64
+		n, err = strconv.ParseUint(text, 10, bits)
+		value = T(n)
+	}
+	if err != nil {
+		return zero, line.Errorf(column, text, "invalid integer: %w", err.(*strconv.NumError).Err)
+	}
+	return value, nil
+}
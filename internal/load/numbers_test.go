@@ -0,0 +1,91 @@
+package load
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func TestInts(t *testing.T) {
+	path := tempFile(t, "1\n -20 \n+3\n")
+	ints, err := Ints[int](path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(ints, []int{1, -20, 3}) {
+		t.Errorf("expected [1 -20 3], got %v", ints)
+	}
+}
+
+func TestInts_Errors(t *testing.T) {
+	tests := []struct {
+		content string
+		line    int
+		column  int
+		err     error
+	}{
+		{"1\nx\n", 2, 1, strconv.ErrSyntax},
+		{"1\n  2 3\n", 2, 3, strconv.ErrSyntax},
+		{"1\n\n2\n", 2, 1, nil},
+		{"1\n300\n", 2, 1, strconv.ErrRange},
+		{"-1\n", 1, 1, strconv.ErrSyntax},
+	}
+	for _, tt := range tests {
+		_, err := Ints[uint8](tempFile(t, tt.content))
+		var pe *ParseError
+		if !errors.As(err, &pe) || pe.Line != tt.line || pe.Column != tt.column || (tt.err != nil && !errors.Is(err, tt.err)) {
+			t.Errorf("%q: expected an error at %d:%d, got %v", tt.content, tt.line, tt.column, err)
+		}
+	}
+}
+
+func TestIntFields(t *testing.T) {
+	path := tempFile(t, "x=5, y=-3..+2\nno numbers\n1-3 a: abc\n10-+4, 5--6\n")
+	fields, err := IntFields(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]int{{5, -3, 2}, {}, {1, 3}, {10, 4, 5, -6}}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("expected %v, got %v", expected, fields)
+	}
+
+	_, err = IntFields(tempFile(t, "a 1\nb 99999999999999999999\n"))
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Line != 2 || pe.Column != 3 || !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("expected an out of range error at 2:3, got %v", err)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		content    string
+		separators string
+		expected   []int64
+	}{
+		{"3,4,1,5\n", ",", []int64{3, 4, 1, 5}},
+		{"3, 4, -1, 5\n", ", ", []int64{3, 4, -1, 5}},
+		{"1 2\n3\n\n4  5\n", " ", []int64{1, 2, 3, 4, 5}},
+		{"", ",", nil},
+		{"1·2·-3\n", "·", []int64{1, 2, -3}},
+		{"1 → 2\n", " →", []int64{1, 2}},
+	}
+	for _, tt := range tests {
+		got, err := Split[int64](tempFile(t, tt.content), tt.separators)
+		if err != nil || !slices.Equal(got, tt.expected) {
+			t.Errorf("%q: expected %v, got %v, %v", tt.content, tt.expected, got, err)
+		}
+	}
+
+	_, err := Split[int](tempFile(t, "1,2\n3,x4,5\n"), ",")
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Line != 2 || pe.Column != 3 || pe.Text != "x4" {
+		t.Errorf("expected an error at 2:3, got %v", err)
+	}
+	_, err = Split[int](tempFile(t, "1·x2\n"), "·")
+	if !errors.As(err, &pe) || pe.Line != 1 || pe.Column != 4 || pe.Text != "x2" {
+		t.Errorf("expected an error at 1:4, got %v", err)
+	}
+}
@@ -0,0 +1,314 @@
+package load
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Pattern parses lines of text into records of type T, which is a struct. A pattern is literal text with fields in
+// braces, such as "{Name} can fly {Speed:int} km/s". Each field names an exported field of T and has an optional type:
+//
+//	{Name} or {Name:string}   any text, as little as possible, for a string field
+//	{Name:word}               letters, digits, and underscores, for a string field
+//	{Name:int}                a decimal integer with an optional sign, for an integer field
+//	{Name:on|off|toggle}      one of the texts separated by |, for a string field
+//
+// A line must match the whole pattern.
+type Pattern[T any] struct {
+	text     string
+	re       *regexp.Regexp
+	segments []segment
+	fields   []patternField // Field set by each group of re, in order
+}
+
+// segment is either literal text or a field of a pattern.
+type segment struct {
+	literal string
+	field   int // Index of the field, or -1 for literal text
+}
+
+// patternField is a field of a pattern and the field of the record that it sets.
+type patternField struct {
+	name    string
+	index   []int // Index of the field of the record, for reflect.Value.FieldByIndex
+	typ     string
+	choices []string       // Texts of a field that is one of them, or nil
+	re      *regexp.Regexp // Prefix matched by a word or int field, or nil
+}
+
+// fieldTypes are the expressions matched by the types of the fields of a pattern.
+var fieldTypes = map[string]string{
+	"string": `.+?`,
+	"word":   `\w+`,
+	"int":    `[-+]?\d+`,
+}
+
+// Compile returns the pattern for records of type T, or an error if the pattern is invalid or does not fit T.
+func Compile[T any](pattern string) (*Pattern[T], error) {
+	record := reflect.TypeFor[T]()
+	if record.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("pattern %q: %v is not a struct", pattern, record)
+	}
+
+	p := &Pattern[T]{text: pattern}
+	var expr strings.Builder
+	expr.WriteString("^")
+	rest := pattern
+	for rest != "" {
+		open := strings.IndexAny(rest, "{}")
+		if open < 0 {
+			expr.WriteString(regexp.QuoteMeta(rest))
+			p.segments = append(p.segments, segment{literal: rest, field: -1})
+			break
+		}
+		if rest[open] == '}' {
+			return nil, fmt.Errorf("pattern %q: unexpected }", pattern)
+		}
+		if open > 0 {
+			expr.WriteString(regexp.QuoteMeta(rest[:open]))
+			p.segments = append(p.segments, segment{literal: rest[:open], field: -1})
+		}
+		rest = rest[open+1:]
+		end := strings.IndexByte(rest, '}')
+		if end < 0 {
+			return nil, fmt.Errorf("pattern %q: missing }", pattern)
+		}
+		f, err := newPatternField(record, rest[:end])
+		if err != nil {
+			return nil, fmt.Errorf("pattern %q: %w", pattern, err)
+		}
+		for _, other := range p.fields {
+			if other.name == f.name {
+				return nil, fmt.Errorf("pattern %q: duplicate field %s", pattern, f.name)
+			}
+		}
+		p.segments = append(p.segments, segment{field: len(p.fields)})
+		p.fields = append(p.fields, f)
+		expr.WriteString("(" + f.expr() + ")")
+		rest = rest[end+1:]
+	}
+	expr.WriteString("$")
+	p.re = regexp.MustCompile(expr.String())
+	return p, nil
+}
+
+// MustCompile is like Compile but panics if the pattern is invalid. It is meant for patterns in package variables.
+func MustCompile[T any](pattern string) *Pattern[T] {
+	p, err := Compile[T](pattern)
+	if err != nil {
+		panic("load: " + err.Error())
+	}
+	return p
+}
+
+// newPatternField returns the field of a pattern described by spec, which is the text between its braces.
+func newPatternField(record reflect.Type, spec string) (patternField, error) {
+	name, typ, _ := strings.Cut(spec, ":")
+	f := patternField{name: name, typ: typ}
+	switch {
+	case typ == "":
+		f.typ = "string"
+	case strings.Contains(typ, "|"):
+		f.typ = "string"
+		f.choices = strings.Split(typ, "|")
+	case fieldTypes[typ] == "":
+		return patternField{}, fmt.Errorf("unknown type %q of field %s", typ, name)
+	case typ != "string":
+		f.re = regexp.MustCompile("^" + fieldTypes[typ])
+	}
+	sf, ok := record.FieldByName(name)
+	if !ok || !sf.IsExported() {
+		return patternField{}, fmt.Errorf("%v has no exported field %q", record, name)
+	}
+	switch kind := sf.Type.Kind(); {
+	case f.typ == "int" && kind >= reflect.Int && kind <= reflect.Int64:
+	case f.typ != "int" && kind == reflect.String:
+	default:
+		return patternField{}, fmt.Errorf("field %s of type %s cannot hold %v", name, typ, sf.Type)
+	}
+	f.index = sf.Index
+	return f, nil
+}
+
+// expr returns the regular expression matched by the field.
+func (f *patternField) expr() string {
+	if f.choices == nil {
+		return fieldTypes[f.typ]
+	}
+	quoted := make([]string, len(f.choices))
+	for i, c := range f.choices {
+		quoted[i] = regexp.QuoteMeta(c)
+	}
+	return strings.Join(quoted, "|")
+}
+
+// String returns the text of the pattern.
+func (p *Pattern[T]) String() string { return p.text }
+
+// Parse returns the record parsed from the line. If the line does not match the pattern, or a number in it is out of
+// the range of its field, the error is a ParseError with the column of the first byte that is not matched.
+func (p *Pattern[T]) Parse(line string) (T, error) {
+	var record T
+	m := p.re.FindStringSubmatchIndex(line)
+	if m == nil {
+		s := search{segments: p.segments, fields: p.fields, line: line, memo: make(map[[2]int]attempt)}
+		at := s.furthest(0, 0).pos
+		return record, &ParseError{Column: at + 1, Text: line[at:], Err: fmt.Errorf("does not match %q", p.text)}
+	}
+	v := reflect.ValueOf(&record).Elem()
+	for i, f := range p.fields {
+		fv := v.FieldByIndex(f.index)
+		start, end := m[2*i+2], m[2*i+3]
+		if f.typ != "int" {
+			fv.SetString(line[start:end])
+			continue
+		}
+		n, err := strconv.ParseInt(line[start:end], 10, fv.Type().Bits())
+		if err != nil {
+			err = fmt.Errorf("invalid %s: %w", f.name, err.(*strconv.NumError).Err)
+			return record, &ParseError{Column: start + 1, Text: line[start:end], Err: err}
+		}
+		fv.SetInt(n)
+	}
+	return record, nil
+}
+
+// attempt is a way of matching the segments of a pattern to a line.
+type attempt struct {
+	progress int  // Number of bytes of literal text and of choices matched, plus the number of other fields matched
+	pos      int  // Offset in the line of the first byte that is not matched
+	ok       bool // Whether the whole line is matched
+}
+
+// better returns true if a matches more of the pattern than b, or as much with less of the line, which locates the
+// error better when a field of any text could otherwise take all of the line.
+func (a attempt) better(b attempt) bool {
+	if a.progress != b.progress {
+		return a.progress > b.progress
+	}
+	return a.pos < b.pos
+}
+
+// maxSearchSteps limits the ways of matching a line that are tried to locate the error in it, so that a long line
+// that does not match a pattern with several fields of any text is reported quickly, if less precisely.
+const maxSearchSteps = 1 << 20
+
+// search locates the error in a line that does not match a pattern.
+type search struct {
+	segments []segment
+	fields   []patternField
+	line     string
+	memo     map[[2]int]attempt // Attempt of each index of a segment and offset in the line that has been tried
+	steps    int
+}
+
+// furthest returns the attempt that matches the most of the segments to the line from pos, or one that matches all of
+// the rest of the line. Its progress only counts segments from i. The attempts are memoized, so the cost is bounded
+// by the number of segments times the square of the length of the line, and the search is cut short after
+// maxSearchSteps.
+func (s *search) furthest(i int, pos int) attempt {
+	if i == len(s.segments) {
+		return attempt{0, pos, pos == len(s.line)}
+	}
+	key := [2]int{i, pos}
+	if a, ok := s.memo[key]; ok {
+		return a
+	}
+	a := s.match(i, pos)
+	s.memo[key] = a
+	return a
+}
+
+// match returns the attempt of furthest for segment i at pos, which is not memoized.
+func (s *search) match(i int, pos int) attempt {
+	s.steps++
+	if s.steps > maxSearchSteps {
+		return attempt{0, pos, false}
+	}
+	seg, rest := s.segments[i], s.line[pos:]
+	if seg.field < 0 {
+		n := commonPrefix(seg.literal, rest)
+		if n < len(seg.literal) {
+			return attempt{n, pos + n, false}
+		}
+		return s.then(i, pos+n, n)
+	}
+
+	// Try each way of matching the field. A choice can be matched in part.
+	f := s.fields[seg.field]
+	best := attempt{0, pos, false}
+	keep := func(a attempt) bool {
+		if a.better(best) || a.ok {
+			best = a
+		}
+		return a.ok
+	}
+	switch {
+	case f.choices != nil:
+		for _, c := range f.choices {
+			n := commonPrefix(c, rest)
+			if n < len(c) {
+				keep(attempt{n, pos + n, false})
+			} else if keep(s.then(i, pos+n, n)) {
+				return best
+			}
+		}
+	case f.re != nil:
+		if loc := f.re.FindStringIndex(rest); loc != nil {
+			keep(s.then(i, pos+loc[1], 1))
+		}
+	default:
+		for end := pos + 1; end <= len(s.line) && s.steps < maxSearchSteps; end++ {
+			s.steps++
+			if keep(s.then(i, end, 1)) {
+				return best
+			}
+		}
+	}
+	return best
+}
+
+// then returns the attempt of matching the segments after segment i from pos, when segment i has progress.
+func (s *search) then(i int, pos int, progress int) attempt {
+	a := s.furthest(i+1, pos)
+	a.progress += progress
+	return a
+}
+
+// commonPrefix returns the length of the longest common prefix of a and b.
+func commonPrefix(a string, b string) int {
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return n
+}
+
+// Lines reads the lines of the file at path and returns the record parsed from each of them. The error of a line that
+// cannot be parsed is a ParseError at its position in the file.
+func (p *Pattern[T]) Lines(path string) ([]T, error) {
+	lines, err := Numbered(path)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]T, len(lines))
+	for i, line := range lines {
+		if records[i], err = p.Parse(line.Text); err != nil {
+			return nil, line.At(1, err)
+		}
+	}
+	return records, nil
+}
+
+// Records reads the lines of the file at path and returns the record of type T parsed from each of them with the
+// pattern.
+func Records[T any](path string, pattern string) ([]T, error) {
+	p, err := Compile[T](pattern)
+	if err != nil {
+		return nil, err
+	}
+	return p.Lines(path)
+}
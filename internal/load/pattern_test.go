@@ -0,0 +1,152 @@
+package load
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+type reindeer struct {
+	Name  string
+	Speed int
+	Fly   int
+	Rest  int64
+}
+
+const reindeerPattern = "{Name} can fly {Speed:int} km/s for {Fly:int} seconds, but then must rest for {Rest:int} seconds."
+
+func TestPattern_Parse(t *testing.T) {
+	p := MustCompile[reindeer](reindeerPattern)
+	r, err := p.Parse("Comet can fly 14 km/s for 10 seconds, but then must rest for 127 seconds.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != (reindeer{"Comet", 14, 10, 127}) {
+		t.Errorf("unexpected record %+v", r)
+	}
+}
+
+func TestPattern_ParseText(t *testing.T) {
+	type instruction struct {
+		Action string
+		X, Y   int
+	}
+	p := MustCompile[instruction]("{Action} {X:int},{Y:int}")
+	r, err := p.Parse("turn off -3,+5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != (instruction{"turn off", -3, 5}) {
+		t.Errorf("unexpected record %+v", r)
+	}
+}
+
+func TestPattern_ParseErrors(t *testing.T) {
+	type small struct {
+		N    int8
+		Word string
+	}
+	p := MustCompile[small]("{N:int} {Word:word}.")
+	tests := []string{
+		"",
+		"1 a",
+		"1 a.b.",
+		"x a.",
+		"128 a.",
+		"1 two words.",
+	}
+	for _, line := range tests {
+		if r, err := p.Parse(line); err == nil {
+			t.Errorf("%q: expected error, got %+v", line, r)
+		}
+	}
+}
+
+func TestCompile_Errors(t *testing.T) {
+	tests := []string{
+		"{Name",
+		"Name}",
+		"{Missing}",
+		"{name}",
+		"{Name:int}",
+		"{Speed:float}",
+		"{Speed}",
+		"{Name} {Name}",
+	}
+	for _, pattern := range tests {
+		if _, err := Compile[reindeer](pattern); err == nil {
+			t.Errorf("%q: expected error", pattern)
+		}
+	}
+	if _, err := Compile[int]("{N:int}"); err == nil {
+		t.Error("expected error for a record that is not a struct")
+	}
+}
+
+func TestPattern_Lines(t *testing.T) {
+	path := tempFile(t, "Comet can fly 14 km/s for 10 seconds, but then must rest for 127 seconds.\n"+
+		"Dancer can fly 16 km/s for 11 seconds, but then must rest for 162 seconds.\n")
+	records, err := Records[reindeer](path, reindeerPattern)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []reindeer{{"Comet", 14, 10, 127}, {"Dancer", 16, 11, 162}}
+	if !slices.Equal(records, expected) {
+		t.Errorf("expected %+v, got %+v", expected, records)
+	}
+}
+
+func TestPattern_LinesError(t *testing.T) {
+	path := tempFile(t, "Comet can fly 14 km/s for 10 seconds, but then must rest for 127 seconds.\nDancer is resting.\n")
+	_, err := MustCompile[reindeer](reindeerPattern).Lines(path)
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Path != path || pe.Line != 2 || pe.Column != 8 || pe.Text != "is resting." {
+		t.Errorf("expected an error at line 2 column 8, got %v", err)
+	}
+}
+
+func TestPattern_ErrorColumn(t *testing.T) {
+	type instruction struct {
+		Action string
+		X, Y   int
+	}
+	p := MustCompile[instruction]("{Action:turn on|turn off|toggle} {X:int},{Y:int} through")
+	tests := []struct {
+		line   string
+		column int
+		text   string
+	}{
+		{"turn up 1,2 through", 6, "up 1,2 through"},
+		{"toggle 1;2 through", 9, ";2 through"},
+		{"turn off 1,2 throgh", 18, "gh"},
+		{"toggle 1,2 through it", 19, " it"},
+		{"toggle 1,99999999999999999999 through", 10, "99999999999999999999"},
+	}
+	for _, tt := range tests {
+		_, err := p.Parse(tt.line)
+		var pe *ParseError
+		if !errors.As(err, &pe) || pe.Column != tt.column || pe.Text != tt.text {
+			t.Errorf("%q: expected an error at column %d at %q, got %v", tt.line, tt.column, tt.text, err)
+		}
+	}
+}
+
+func TestPattern_ErrorColumnLongLine(t *testing.T) {
+	type words struct{ A, B, C, D string }
+	p := MustCompile[words]("{A} {B} {C} {D}!")
+	for _, n := range []int{800, 100000} {
+		line := strings.Repeat("a ", n)
+		start := time.Now()
+		_, err := p.Parse(line)
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("%d bytes: locating the error took %v", len(line), elapsed)
+		}
+		// The ! is missing after the fourth word.
+		var pe *ParseError
+		if !errors.As(err, &pe) || pe.Column != 8 {
+			t.Errorf("%d bytes: expected an error at column 8, got %.80v", len(line), err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package load
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+)
+
+// DefaultMaxLineLength is the limit on the length of a line read by a LineReader that does not set one, in bytes.
+const DefaultMaxLineLength = 16 << 20
+
+// LineReader reads the lines of a file one at a time, so that a large file is processed in constant memory. The standard
+// input is the exception: it is held in memory in full, because every reader of Stdin must see the same content, so
+// "-file -" is not read in constant memory. Like a bufio.Scanner, it stops at the first error, which is returned by Err
+// when the iteration is done:
+//
+//	var r load.LineReader
+//	for n, line := range r.Lines(path) {
+//		...
+//	}
+//	if err := r.Err(); err != nil {
+//		...
+//	}
+type LineReader struct {
+	MaxLength int // Limit on the length of a line in bytes, or 0 for DefaultMaxLineLength
+
+	err error
+}
+
+// Lines returns an iterator over the lines of the file at path, or of the standard input if the path is Stdin, and their
+// numbers, from 1. Line endings, including a carriage return before the newline, are removed, and blank lines at the end
+// of the file are skipped. A line longer than the limit is an error.
+func (r *LineReader) Lines(path string) iter.Seq2[int, string] {
+	return func(yield func(int, string) bool) {
+		r.err = nil
+		f, err := open(path)
+		if err != nil {
+			r.err = err
+			return
+		}
+		defer f.Close()
+
+		maxLength := r.MaxLength
+		if maxLength <= 0 {
+			maxLength = DefaultMaxLineLength
+		}
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(nil, maxLength+len("\r\n"))
+		n := 0
+		blank := 0 // Number of blank lines not yet yielded, which are skipped if they are at the end
+		for scanner.Scan() {
+			n++
+			line := scanner.Text()
+			if len(line) > maxLength {
+				r.err = &ParseError{Path: path, Line: n, Err: fmt.Errorf("line is longer than %d bytes", maxLength)}
+				return
+			}
+			if line == "" {
+				blank++
+				continue
+			}
+			for i := n - blank; i < n; i++ {
+				if !yield(i, "") {
+					return
+				}
+			}
+			blank = 0
+			if !yield(n, line) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			if err == bufio.ErrTooLong {
+				err = &ParseError{Path: path, Line: n + 1, Err: fmt.Errorf("line is longer than %d bytes", maxLength)}
+			}
+			r.err = err
+		}
+	}
+}
+
+// Err returns the error that stopped the last iteration, or nil if it read all of the lines.
+func (r *LineReader) Err() error {
+	return r.err
+}
+
+// open opens the file at path for reading, or the standard input if the path is Stdin. The standard input is read only
+// once, so that every reader of Stdin sees the same content.
+func open(path string) (io.ReadCloser, error) {
+	if path != Stdin {
+		return os.Open(path)
+	}
+	data, err := stdin.read()
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
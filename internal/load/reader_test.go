@@ -0,0 +1,109 @@
+package load
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// collect returns the numbers and lines read by the reader from the file at path.
+func collect(r *LineReader, path string) ([]int, []string) {
+	var numbers []int
+	var lines []string
+	for n, line := range r.Lines(path) {
+		numbers = append(numbers, n)
+		lines = append(lines, line)
+	}
+	return numbers, lines
+}
+
+func TestLineReader_Lines(t *testing.T) {
+	path := tempFile(t, "aaa\r\n\nbbb\n\n\n")
+	var r LineReader
+	numbers, lines := collect(&r, path)
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(numbers, []int{1, 2, 3}) || !slices.Equal(lines, []string{"aaa", "", "bbb"}) {
+		t.Errorf("unexpected lines %v %q", numbers, lines)
+	}
+}
+
+func TestLineReader_Break(t *testing.T) {
+	path := tempFile(t, "aaa\nbbb\nccc\n")
+	var r LineReader
+	for n := range r.Lines(path) {
+		if n == 2 {
+			break
+		}
+	}
+	if err := r.Err(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLineReader_MaxLength(t *testing.T) {
+	tests := []struct {
+		content string
+		line    int // Number of the line that is too long, or 0 if none is
+	}{
+		{"aaaa\nbbbb\n", 0},
+		{"aaaa\r\nbbbb", 0},
+		{"aaaa\nbbbbb\nccc\n", 2},
+		{"aaaa\nbbbbb", 2},
+		{"aaaaaaaaaaaaaaaaaaaa\n", 1},
+	}
+	for _, tt := range tests {
+		path := tempFile(t, tt.content)
+		r := LineReader{MaxLength: 4}
+		collect(&r, path)
+		err := r.Err()
+		var pe *ParseError
+		switch {
+		case tt.line == 0 && err != nil:
+			t.Errorf("%q: unexpected error %v", tt.content, err)
+		case tt.line != 0 && (!errors.As(err, &pe) || pe.Line != tt.line):
+			t.Errorf("%q: expected an error at line %d, got %v", tt.content, tt.line, err)
+		}
+	}
+}
+
+func TestLineReader_Large(t *testing.T) {
+	line := strings.Repeat("x", 100000)
+	path := tempFile(t, strings.Repeat(line+"\n", 50))
+	var r LineReader
+	count := 0
+	for _, l := range r.Lines(path) {
+		if l != line {
+			t.Fatalf("unexpected line of length %d", len(l))
+		}
+		count++
+	}
+	if err := r.Err(); err != nil || count != 50 {
+		t.Errorf("expected 50 lines, got %d, %v", count, err)
+	}
+}
+
+func TestLineReader_Errors(t *testing.T) {
+	var r LineReader
+	if _, lines := collect(&r, "/nonexistent/path/file.txt"); lines != nil || r.Err() == nil {
+		t.Fatal("expected error for nonexistent file")
+	}
+
+	// The error is reset by the next iteration.
+	collect(&r, tempFile(t, "aaa\n"))
+	if err := r.Err(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestLineReader_Stdin(t *testing.T) {
+	withStdin(t, "aaa\nbbb\n")
+	for range 2 {
+		var r LineReader
+		if _, lines := collect(&r, Stdin); r.Err() != nil || !slices.Equal(lines, []string{"aaa", "bbb"}) {
+			t.Fatalf("unexpected lines %q, %v", lines, r.Err())
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package load
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Sections reads the lines of the provided file path and splits them into sections, which are separated by one or more
+// blank lines. A line of only spaces is blank.
+func Sections(path string) ([][]string, error) {
+	sections, err := numberedSections(path, 0)
+	if err != nil {
+		return nil, err
+	}
+	result := make([][]string, len(sections))
+	for i, section := range sections {
+		result[i] = make([]string, len(section))
+		for j, line := range section {
+			result[i][j] = line.Text
+		}
+	}
+	return result, nil
+}
+
+// ParseSections reads the sections of the provided file path, like Sections, and returns the value parsed from each of
+// them by parse. The lines of a section are numbered, so that parse can report the position of an error. If n is not
+// 0, the file must have exactly n sections.
+func ParseSections[T any](path string, n int, parse func(section []Line) (T, error)) ([]T, error) {
+	sections, err := numberedSections(path, n)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]T, len(sections))
+	for i, section := range sections {
+		if values[i], err = parse(section); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// HeaderBody reads a file of two sections, like Sections, such as a list of rules followed by a message, and returns
+// the values parsed from the first by parseHeader and from the second by parseBody.
+func HeaderBody[H any, B any](path string, parseHeader func(section []Line) (H, error),
+	parseBody func(section []Line) (B, error)) (H, B, error) {
+	var header H
+	var body B
+	sections, err := numberedSections(path, 2)
+	if err != nil {
+		return header, body, err
+	}
+	if header, err = parseHeader(sections[0]); err != nil {
+		return header, body, err
+	}
+	body, err = parseBody(sections[1])
+	return header, body, err
+}
+
+// numberedSections reads the lines of the file at path, with their numbers, and splits them into sections at blank
+// lines. If n is not 0, it is a ParseError at the first missing or extra section if the file does not have n sections.
+func numberedSections(path string, n int) ([][]Line, error) {
+	var r LineReader
+	var sections [][]Line
+	last := 0         // Number of the last line
+	extra := 0        // Number of the first line of section n+1, if there is one
+	separated := true // Whether the next line that is not blank starts a section
+	for number, text := range r.Lines(path) {
+		last = number
+		if strings.TrimSpace(text) == "" {
+			separated = true
+			continue
+		}
+		if separated {
+			if n > 0 && len(sections) == n {
+				extra = number
+			}
+			sections = append(sections, nil)
+			separated = false
+		}
+		sections[len(sections)-1] = append(sections[len(sections)-1], Line{Path: path, Number: number, Text: text})
+	}
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+
+	if n == 0 || len(sections) == n {
+		return sections, nil
+	}
+	err := fmt.Errorf("expected %d sections separated by blank lines, found %d", n, len(sections))
+	if len(sections) > n {
+		return nil, &ParseError{Path: path, Line: extra, Err: err}
+	}
+	return nil, &ParseError{Path: path, Line: last + 1, Err: err}
+}
@@ -0,0 +1,87 @@
+package load
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestSections(t *testing.T) {
+	path := tempFile(t, "\na => b\nc => d\n\n  \nabc\n\nx\ny\n\n")
+	sections, err := Sections(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]string{{"a => b", "c => d"}, {"abc"}, {"x", "y"}}
+	if !reflect.DeepEqual(sections, expected) {
+		t.Errorf("expected %q, got %q", expected, sections)
+	}
+}
+
+func TestSections_EmptyFile(t *testing.T) {
+	sections, err := Sections(tempFile(t, ""))
+	if err != nil || len(sections) != 0 {
+		t.Errorf("expected no sections, got %q, %v", sections, err)
+	}
+}
+
+func TestParseSections(t *testing.T) {
+	path := tempFile(t, "1\n2\n\n3\n")
+	sum := func(section []Line) (int, error) {
+		total := 0
+		for _, line := range section {
+			n, err := line.Atoi(1, line.Text)
+			if err != nil {
+				return 0, err
+			}
+			total += n
+		}
+		return total, nil
+	}
+	sums, err := ParseSections(path, 0, sum)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(sums, []int{3, 3}) {
+		t.Errorf("expected [3 3], got %v", sums)
+	}
+
+	// The error of the parser has the position of the line.
+	_, err = ParseSections(tempFile(t, "1\n\n2\nx\n"), 2, sum)
+	var pe *ParseError
+	if !errors.As(err, &pe) || pe.Line != 4 || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected an error at line 4, got %v", err)
+	}
+}
+
+func TestParseSections_Count(t *testing.T) {
+	tests := []struct {
+		content string
+		line    int
+	}{
+		{"a\nb\n", 3},
+		{"a\n\nb\n\nc\nd\n", 5},
+		{"", 1},
+	}
+	keep := func(section []Line) ([]Line, error) { return section, nil }
+	for _, tt := range tests {
+		_, err := ParseSections(tempFile(t, tt.content), 2, keep)
+		var pe *ParseError
+		if !errors.As(err, &pe) || pe.Line != tt.line {
+			t.Errorf("%q: expected an error at line %d, got %v", tt.content, tt.line, err)
+		}
+	}
+}
+
+func TestHeaderBody(t *testing.T) {
+	count := func(section []Line) (int, error) { return len(section), nil }
+	text := func(section []Line) (string, error) { return section[0].Text, nil }
+	header, body, err := HeaderBody(tempFile(t, "a\nb\n\nmessage\n"), count, text)
+	if err != nil || header != 2 || body != "message" {
+		t.Errorf("unexpected header %d and body %q, %v", header, body, err)
+	}
+	if _, _, err := HeaderBody(tempFile(t, "a\nb\n"), count, text); err == nil {
+		t.Error("expected error for a missing body")
+	}
+}
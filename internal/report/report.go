@@ -0,0 +1,136 @@
+// Package report generates the tables of answers and algorithms in the README from the results of the solvers.
+package report
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+// Day is the report of one day of one year.
+type Day struct {
+	Year    int
+	Day     int
+	Results []setup.Result // Results of the parts that the puzzle has
+	Tags    []string       // Algorithms and techniques declared by the solver
+}
+
+// Collect runs both parts of every registered day of the year with the given parameters and returns the reports of
+// the days. It fails if any part fails.
+func Collect(ctx context.Context, year int, params setup.Params) ([]Day, error) {
+	params.Year = year
+	params.Parts = []int{1, 2}
+
+	var days []Day
+	for _, day := range setup.Days(year) {
+		results, err := setup.Run(ctx, day, params)
+		if err != nil {
+			return nil, err
+		}
+		d := Day{Year: year, Day: day}
+		for _, r := range results {
+			if errors.Is(r.Err, setup.ErrNoPart) {
+				continue
+			}
+			if r.Err != nil {
+				return nil, fmt.Errorf("%d day %d part %d: %w", year, day, r.Part, r.Err)
+			}
+			d.Results = append(d.Results, r)
+		}
+		if solver, ok := setup.Lookup(year, day); ok {
+			if t, ok := solver.(setup.Tagged); ok {
+				d.Tags = t.Tags()
+			}
+		}
+		days = append(days, d)
+	}
+	return days, nil
+}
+
+// AnswerTable returns the table of the answers of the parts of a day and the times taken to solve them.
+func AnswerTable(d Day) []string {
+	rows := make([][]string, 0, len(d.Results))
+	for _, r := range d.Results {
+		rows = append(rows, []string{fmt.Sprint(r.Part), fmt.Sprint(r.Answer), formatTime(r.SolveTime)})
+	}
+	return table([]string{"Part", "Answer", "Time"}, []bool{true, true, true}, rows)
+}
+
+// AlgorithmTable returns the table of the algorithms and techniques declared by the solvers of the days, in
+// alphabetical order, with the days that use each of them.
+func AlgorithmTable(days []Day) []string {
+	uses := make(map[string][]string)
+	for _, d := range days {
+		for _, tag := range d.Tags {
+			uses[tag] = append(uses[tag], fmt.Sprintf("%02d", d.Day))
+		}
+	}
+	tags := make([]string, 0, len(uses))
+	for tag := range uses {
+		tags = append(tags, tag)
+	}
+	slices.SortFunc(tags, func(a, b string) int { return strings.Compare(strings.ToLower(a), strings.ToLower(b)) })
+
+	rows := make([][]string, 0, len(tags))
+	for _, tag := range tags {
+		rows = append(rows, []string{tag, strings.Join(uses[tag], ", ")})
+	}
+	return table([]string{"Algorithm / Technique", "Days"}, []bool{false, false}, rows)
+}
+
+// table returns the lines of a Markdown table with the given header and rows. The cells of each column are padded to
+// the same width and aligned to the right if right is true for the column, or to the left otherwise. The headers are
+// centered.
+func table(header []string, right []bool, rows [][]string) []string {
+	widths := make([]int, len(header))
+	for i, h := range header {
+		widths[i] = len([]rune(h))
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], len([]rune(cell)))
+		}
+	}
+
+	line := func(cells []string, format func(i int, cell string) string) string {
+		var b strings.Builder
+		b.WriteString("|")
+		for i, cell := range cells {
+			b.WriteString(" " + format(i, cell) + " |")
+		}
+		return b.String()
+	}
+	lines := []string{line(header, func(i int, cell string) string {
+		pad := widths[i] - len([]rune(cell))
+		return strings.Repeat(" ", (pad+1)/2) + cell + strings.Repeat(" ", pad/2)
+	})}
+	rule := make([]string, len(header))
+	for i := range rule {
+		rule[i] = strings.Repeat("-", widths[i])
+	}
+	lines = append(lines, strings.ReplaceAll(line(rule, func(i int, cell string) string { return cell }), " ", "-"))
+	for _, row := range rows {
+		lines = append(lines, line(row, func(i int, cell string) string {
+			pad := strings.Repeat(" ", widths[i]-len([]rune(cell)))
+			if right[i] {
+				return pad + cell
+			}
+			return cell + pad
+		}))
+	}
+	return lines
+}
+
+// formatTime returns the duration rounded to three significant digits, which is as precise as a single run can be.
+func formatTime(d time.Duration) string {
+	unit := time.Duration(1)
+	for d >= 1000*unit {
+		unit *= 10
+	}
+	return d.Round(unit).String()
+}
@@ -0,0 +1,97 @@
+package report
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+// fakeSolver answers part 1 with the length of its input path and does not have part 2.
+type fakeSolver struct {
+	path string
+}
+
+func (s *fakeSolver) Parse(path string) error                { s.path = path; return nil }
+func (s *fakeSolver) Part1(ctx context.Context) (any, error) { return len(s.path), nil }
+func (s *fakeSolver) Part2(ctx context.Context) (any, error) { return nil, setup.ErrNoPart }
+func (s *fakeSolver) Tags() []string                         { return []string{"Brute force"} }
+
+// testYear is a year that no real solver is registered for.
+const testYear = 1
+
+func init() {
+	setup.Register(testYear, 3, func() setup.Solver { return &fakeSolver{} })
+}
+
+func TestCollect(t *testing.T) {
+	days, err := Collect(t.Context(), testYear, setup.Params{Path: "input.txt"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(days) != 1 || days[0].Day != 3 || !slices.Equal(days[0].Tags, []string{"Brute force"}) {
+		t.Fatalf("unexpected days %+v", days)
+	}
+	if results := days[0].Results; len(results) != 1 || results[0].Part != 1 || results[0].Answer != len("input.txt") {
+		t.Errorf("expected only the result of part 1, got %+v", results)
+	}
+}
+
+func TestAnswerTable(t *testing.T) {
+	d := Day{Year: 2015, Day: 24, Results: []setup.Result{
+		{Part: 1, Answer: 11266889531, SolveTime: 1234567 * time.Nanosecond},
+		{Part: 2, Answer: 77387711, SolveTime: 98 * time.Microsecond},
+	}}
+	expected := []string{
+		"| Part |    Answer   |  Time  |",
+		"|------|-------------|--------|",
+		"|    1 | 11266889531 | 1.23ms |",
+		"|    2 |    77387711 |   98µs |",
+	}
+	if lines := AnswerTable(d); !slices.Equal(lines, expected) {
+		t.Errorf("expected\n%s\ngot\n%s", strings.Join(expected, "\n"), strings.Join(lines, "\n"))
+	}
+}
+
+func TestAlgorithmTable(t *testing.T) {
+	days := []Day{
+		{Day: 7, Tags: []string{"Memoization"}},
+		{Day: 9, Tags: []string{"Permutations"}},
+		{Day: 19, Tags: []string{"A* search"}},
+		{Day: 22, Tags: []string{"Memoization", "depth-first search"}},
+		{Day: 23},
+	}
+	expected := []string{
+		"| Algorithm / Technique |  Days  |",
+		"|-----------------------|--------|",
+		"| A* search             | 19     |",
+		"| depth-first search    | 22     |",
+		"| Memoization           | 07, 22 |",
+		"| Permutations          | 09     |",
+	}
+	if lines := AlgorithmTable(days); !slices.Equal(lines, expected) {
+		t.Errorf("expected\n%s\ngot\n%s", strings.Join(expected, "\n"), strings.Join(lines, "\n"))
+	}
+}
+
+func TestFormatTime(t *testing.T) {
+	tests := []struct {
+		d        time.Duration
+		expected string
+	}{
+		{0, "0s"},
+		{999, "999ns"},
+		{123456, "123µs"},
+		{1234567, "1.23ms"},
+		{9876543210, "9.88s"},
+		{90 * time.Second, "1m30s"},
+	}
+	for _, tt := range tests {
+		if s := formatTime(tt.d); s != tt.expected {
+			t.Errorf("%d: expected %s, got %s", tt.d, tt.expected, s)
+		}
+	}
+}
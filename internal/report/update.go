@@ -0,0 +1,94 @@
+package report
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Markers of the regions of a document that are generated. A region starts with a line such as
+// "<!-- report answers 2015 7 -->" or "<!-- report algorithms 2015 -->" and ends with the line "<!-- end report -->".
+const (
+	beginMarker = "<!-- report "
+	endMarker   = "<!-- end report -->"
+)
+
+// Update returns the document with each marked region of the given year replaced by its table. The answers region of
+// a day is replaced by AnswerTable, and the algorithms region by AlgorithmTable. Everything outside the regions,
+// including the regions of other years, is left unchanged.
+func Update(doc string, year int, days []Day) (string, error) {
+	byDay := make(map[int]Day, len(days))
+	for _, d := range days {
+		byDay[d.Day] = d
+	}
+
+	lines := strings.Split(doc, "\n")
+	var out []string
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		out = append(out, line)
+		if !strings.HasPrefix(strings.TrimSpace(line), beginMarker) {
+			continue
+		}
+
+		end := i + 1
+		for end < len(lines) && strings.TrimSpace(lines[end]) != endMarker {
+			if strings.HasPrefix(strings.TrimSpace(lines[end]), beginMarker) {
+				return "", fmt.Errorf("line %d: region starts inside the region of line %d", end+1, i+1)
+			}
+			end++
+		}
+		if end == len(lines) {
+			return "", fmt.Errorf("line %d: region has no %q", i+1, endMarker)
+		}
+
+		table, err := regionTable(strings.TrimSpace(line), year, byDay, days)
+		if err != nil {
+			return "", fmt.Errorf("line %d: %w", i+1, err)
+		}
+		if table == nil {
+			table = lines[i+1 : end] // The region is of another year.
+		}
+		out = append(out, table...)
+		out = append(out, lines[end])
+		i = end
+	}
+	return strings.Join(out, "\n"), nil
+}
+
+// regionTable returns the table of the region that starts with the given marker, or nil if the region is not of the
+// given year.
+func regionTable(marker string, year int, byDay map[int]Day, days []Day) ([]string, error) {
+	fields := strings.Fields(strings.TrimSuffix(strings.TrimPrefix(marker, beginMarker), "-->"))
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("invalid marker %q", marker)
+	}
+	kind := fields[0]
+	numbers := make([]int, len(fields)-1)
+	for i, f := range fields[1:] {
+		n, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, fmt.Errorf("invalid marker %q", marker)
+		}
+		numbers[i] = n
+	}
+
+	switch {
+	case kind == "answers" && len(numbers) == 2:
+		if numbers[0] != year {
+			return nil, nil
+		}
+		d, ok := byDay[numbers[1]]
+		if !ok {
+			return nil, fmt.Errorf("no results for %d day %d", year, numbers[1])
+		}
+		return AnswerTable(d), nil
+	case kind == "algorithms" && len(numbers) == 1:
+		if numbers[0] != year {
+			return nil, nil
+		}
+		return AlgorithmTable(days), nil
+	default:
+		return nil, fmt.Errorf("invalid marker %q", marker)
+	}
+}
@@ -0,0 +1,88 @@
+package report
+
+import (
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+var days = []Day{
+	{Year: 2015, Day: 1, Results: []setup.Result{{Part: 1, Answer: 280}, {Part: 2, Answer: 1797}}, Tags: []string{"Counting"}},
+}
+
+func TestUpdate(t *testing.T) {
+	doc := `# Title
+
+## Day 1
+
+Trivial.
+
+<!-- report answers 2015 1 -->
+| Part | Answer |
+|------|--------|
+|    1 |    279 |
+<!-- end report -->
+
+<!-- report answers 2016 1 -->
+| Part | Answer |
+<!-- end report -->
+
+## Algorithms
+
+<!-- report algorithms 2015 -->
+<!-- end report -->
+`
+	expected := `# Title
+
+## Day 1
+
+Trivial.
+
+<!-- report answers 2015 1 -->
+| Part | Answer | Time |
+|------|--------|------|
+|    1 |    280 |   0s |
+|    2 |   1797 |   0s |
+<!-- end report -->
+
+<!-- report answers 2016 1 -->
+| Part | Answer |
+<!-- end report -->
+
+## Algorithms
+
+<!-- report algorithms 2015 -->
+| Algorithm / Technique | Days |
+|-----------------------|------|
+| Counting              | 01   |
+<!-- end report -->
+`
+	updated, err := Update(doc, 2015, days)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, updated)
+	}
+
+	// Updating is idempotent.
+	if again, err := Update(updated, 2015, days); err != nil || again != updated {
+		t.Errorf("expected no change, got %v\n%s", err, again)
+	}
+}
+
+func TestUpdate_Errors(t *testing.T) {
+	tests := []string{
+		"<!-- report answers 2015 1 -->\n| Part |\n",
+		"<!-- report answers 2015 1 -->\n<!-- report algorithms 2015 -->\n<!-- end report -->\n",
+		"<!-- report answers 2015 2 -->\n<!-- end report -->\n",
+		"<!-- report answers 2015 -->\n<!-- end report -->\n",
+		"<!-- report summary 2015 -->\n<!-- end report -->\n",
+		"<!-- report answers 2015 x -->\n<!-- end report -->\n",
+	}
+	for _, doc := range tests {
+		if _, err := Update(doc, 2015, days); err == nil {
+			t.Errorf("%q: expected error", doc)
+		}
+	}
+}
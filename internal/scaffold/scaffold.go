@@ -0,0 +1,168 @@
+// Package scaffold generates the files of the solution of a new day from templates.
+package scaffold
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+// daysFile is the path of the file that imports the package of every day, relative to the root of the module.
+const daysFile = "internal/days/days.go"
+
+// data is the data of the templates.
+type data struct {
+	Year    int
+	Day     int
+	Package string // Name of the package of the day, such as day07
+	Module  string // Path of the module
+}
+
+// file is a file to generate from a template.
+type file struct {
+	path     string
+	template string
+}
+
+// New creates the package of the solver of the given day of the given year in the module at root, and an empty example
+// with a stub of its expected answers in the data directory dir. Then it imports the package in internal/days/days.go
+// so that the solver is registered. It fails if the package already exists, and it does not overwrite existing data
+// files. It returns the paths of the files that are created or changed.
+func New(root string, dir string, year int, day int) ([]string, error) {
+	if day < 1 || day > 25 {
+		return nil, fmt.Errorf("invalid day %d, must be 1 to 25", day)
+	}
+	module, err := modulePath(filepath.Join(root, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+	d := data{Year: year, Day: day, Package: fmt.Sprintf("day%02d", day), Module: module}
+
+	pkgPath := fmt.Sprintf("internal/days/%d/%s", year, d.Package)
+	pkgDir := filepath.Join(root, pkgPath)
+	if _, err := os.Stat(pkgDir); err == nil {
+		return nil, fmt.Errorf("%s already exists", pkgDir)
+	}
+
+	files := []file{
+		{filepath.Join(pkgDir, d.Package+".go"), "solver.go.tmpl"},
+		{filepath.Join(pkgDir, d.Package+"_test.go"), "solver_test.go.tmpl"},
+		{setup.ExamplePath(dir, year, day, 1), ""},
+		{setup.ExampleAnswersPath(dir, year, day, 1), "example-answers.txt.tmpl"},
+	}
+	var created []string
+	for _, f := range files {
+		ok, err := generate(f, d)
+		if err != nil {
+			return created, err
+		}
+		if ok {
+			created = append(created, f.path)
+		}
+	}
+
+	path := filepath.Join(root, daysFile)
+	if err := addImport(path, module+"/"+pkgPath); err != nil {
+		return created, err
+	}
+	return append(created, path), nil
+}
+
+// generate writes the file from its template, or an empty file if it has none. Go source is formatted. It returns
+// false if the file already exists.
+func generate(f file, d data) (bool, error) {
+	if _, err := os.Stat(f.path); err == nil {
+		return false, nil
+	}
+
+	var buf bytes.Buffer
+	if f.template != "" {
+		t, err := template.ParseFS(templates, "templates/"+f.template)
+		if err != nil {
+			return false, err
+		}
+		if err := t.Execute(&buf, d); err != nil {
+			return false, err
+		}
+	}
+	content := buf.Bytes()
+	if strings.HasSuffix(f.path, ".go") {
+		var err error
+		if content, err = format.Source(content); err != nil {
+			return false, fmt.Errorf("%s: %w", f.path, err)
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(f.path), 0o755); err != nil {
+		return false, err
+	}
+	// O_EXCL makes sure that a file created since the check above is not overwritten.
+	out, err := os.OpenFile(f.path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	if _, err := out.Write(content); err != nil {
+		out.Close()
+		return false, err
+	}
+	return true, out.Close()
+}
+
+// addImport adds a blank import of the package to the Go file at path, which must import its packages in a single
+// block. The imports are kept sorted by gofmt.
+func addImport(path string, pkg string) error {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	line := fmt.Sprintf("\t_ %q\n", pkg)
+	if bytes.Contains(src, []byte(line)) {
+		return nil
+	}
+	i := bytes.Index(src, []byte("import (\n"))
+	if i < 0 {
+		return fmt.Errorf("%s: no import block", path)
+	}
+	i += len("import (\n")
+	out := append(append(append([]byte{}, src[:i]...), line...), src[i:]...)
+	if out, err = format.Source(out); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return os.WriteFile(path, out, 0o644)
+}
+
+// modulePath returns the path of the module declared in the go.mod file at path.
+func modulePath(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if module, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(module), `"`), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%s: no module declaration", path)
+}
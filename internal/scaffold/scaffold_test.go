@@ -0,0 +1,133 @@
+package scaffold
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const daysSource = `// Package days imports the solution for every day.
+package days
+
+import (
+	_ "example.com/aoc/internal/days/2015/day01"
+	_ "example.com/aoc/internal/days/2015/day12"
+)
+`
+
+// newModule creates a module with the given days file in a temporary directory and returns its root.
+func newModule(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	files := map[string]string{
+		"go.mod": "module example.com/aoc\n\ngo 1.25\n",
+		daysFile: daysSource,
+	}
+	for path, content := range files {
+		path = filepath.Join(root, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestNew(t *testing.T) {
+	root := newModule(t)
+	dir := filepath.Join(root, "data")
+	paths, err := New(root, dir, 2015, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		filepath.Join(root, "internal/days/2015/day07/day07.go"),
+		filepath.Join(root, "internal/days/2015/day07/day07_test.go"),
+		filepath.Join(dir, "2015/day07/day07-example1.txt"),
+		filepath.Join(dir, "2015/day07/day07-example1-answers.txt"),
+		filepath.Join(root, daysFile),
+	}
+	if strings.Join(paths, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("expected %v, got %v", expected, paths)
+	}
+
+	for _, path := range expected[:2] {
+		f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ImportsOnly)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.Name.Name != "day07" {
+			t.Errorf("%s: expected package day07, got %s", path, f.Name.Name)
+		}
+	}
+	src, err := os.ReadFile(expected[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(src), "setup.Register(2015, 7,") || !strings.Contains(string(src), `"example.com/aoc/internal/setup"`) {
+		t.Errorf("unexpected solver:\n%s", src)
+	}
+
+	days, err := os.ReadFile(filepath.Join(root, daysFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	imports := `	_ "example.com/aoc/internal/days/2015/day01"
+	_ "example.com/aoc/internal/days/2015/day07"
+	_ "example.com/aoc/internal/days/2015/day12"
+`
+	if !strings.Contains(string(days), imports) {
+		t.Errorf("expected the imports to be sorted:\n%s", days)
+	}
+}
+
+func TestNew_Exists(t *testing.T) {
+	root := newModule(t)
+	dir := filepath.Join(root, "data")
+	if _, err := New(root, dir, 2015, 7); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(root, dir, 2015, 7); err == nil {
+		t.Fatal("expected error for an existing day")
+	}
+}
+
+func TestNew_KeepsData(t *testing.T) {
+	root := newModule(t)
+	dir := filepath.Join(root, "data")
+	example := filepath.Join(dir, "2015/day03/day03-example1.txt")
+	if err := os.MkdirAll(filepath.Dir(example), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(example, []byte("^>v<\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	paths, err := New(root, dir, 2015, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		if path == example {
+			t.Error("expected the existing example not to be reported as created")
+		}
+	}
+	if content, _ := os.ReadFile(example); string(content) != "^>v<\n" {
+		t.Errorf("expected the existing example to be kept, got %q", content)
+	}
+}
+
+func TestNew_InvalidDay(t *testing.T) {
+	root := newModule(t)
+	for _, day := range []int{0, 26} {
+		if _, err := New(root, filepath.Join(root, "data"), 2015, day); err == nil {
+			t.Errorf("day %d: expected error", day)
+		}
+	}
+}
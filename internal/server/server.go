@@ -0,0 +1,257 @@
+// Package server serves a page listing the registered days and an API that solves a part of a day for an input
+// given in the request.
+package server
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"net/http"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+// DefaultMaxInput is the default limit on the size of the input in a request, in bytes.
+const DefaultMaxInput = 4 << 20
+
+// DefaultMaxSolves is the default limit on the number of parts that are solved at the same time, which is one per CPU.
+var DefaultMaxSolves = runtime.GOMAXPROCS(0)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+var index = template.Must(template.New("index.html.tmpl").
+	Funcs(template.FuncMap{"join": strings.Join}).
+	ParseFS(templates, "templates/index.html.tmpl"))
+
+// Server handles the requests for the solvers in the registry.
+//
+// A solver that does not check its context, such as those of days 10, 17, 20, and 24 of 2015, keeps running after the
+// timeout of its request until it returns. It holds its slot of MaxSolves until then, so a slow day cannot start more
+// runs than MaxSolves.
+type Server struct {
+	Year      int           // Year of the puzzles when a request does not select one
+	Timeout   time.Duration // Time allowed to solve a part of a request, or 0 for no limit
+	MaxInput  int64         // Limit on the size of the input in a request, in bytes
+	MaxSolves int           // Limit on the number of parts solved at the same time, or 0 for no limit
+
+	slotsOnce sync.Once
+	slots     chan struct{} // Slots of the parts being solved, or nil if there is no limit
+}
+
+// New returns a server for the given default year and time allowed for a request.
+func New(year int, timeout time.Duration) *Server {
+	return &Server{Year: year, Timeout: timeout, MaxInput: DefaultMaxInput, MaxSolves: DefaultMaxSolves}
+}
+
+// Handler returns the handler of the routes of the server:
+//
+//	GET /                      the page listing the registered days of each year
+//	POST /solve/{day}/{part}   the answer to a part of a day for the input in the body, as a JSON result
+//
+// The year of a solve request is selected by the year query parameter. A solve request is answered with the status 503
+// while MaxSolves parts are being solved.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{$}", s.handleIndex)
+	mux.HandleFunc("POST /solve/{day}/{part}", s.handleSolve)
+	return mux
+}
+
+// yearPage is the data of a year on the index page.
+type yearPage struct {
+	Year int
+	Days []dayPage
+}
+
+// dayPage is the data of a day on the index page.
+type dayPage struct {
+	Year int
+	Day  int
+	Tags []string
+}
+
+// handleIndex serves the page listing the registered days.
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	var years []yearPage
+	for _, year := range setup.Years() {
+		yp := yearPage{Year: year}
+		for _, day := range setup.Days(year) {
+			dp := dayPage{Year: year, Day: day}
+			if solver, ok := setup.Lookup(year, day); ok {
+				if t, ok := solver.(setup.Tagged); ok {
+					dp.Tags = t.Tags()
+				}
+			}
+			yp.Days = append(yp.Days, dp)
+		}
+		years = append(years, yp)
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	data := struct {
+		Year  int
+		Years []yearPage
+	}{s.Year, years}
+	if err := index.Execute(w, data); err != nil {
+		setup.Logger(r.Context()).Error("cannot render the index page", "error", err)
+	}
+}
+
+// handleSolve solves a part of a day for the input in the body of the request.
+func (s *Server) handleSolve(w http.ResponseWriter, r *http.Request) {
+	year := s.Year
+	if y := r.URL.Query().Get("year"); y != "" {
+		var err error
+		if year, err = strconv.Atoi(y); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("invalid year %q", y))
+			return
+		}
+	}
+	day, err := strconv.Atoi(r.PathValue("day"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid day %q", r.PathValue("day")))
+		return
+	}
+	part, err := strconv.Atoi(r.PathValue("part"))
+	if err != nil || part < 1 || part > 2 {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("invalid part %q, must be 1 or 2", r.PathValue("part")))
+		return
+	}
+	if _, ok := setup.Lookup(year, day); !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("no solver registered for %d day %d", year, day))
+		return
+	}
+
+	release, ok := s.acquire()
+	if !ok {
+		w.Header().Set("Retry-After", "1")
+		writeError(w, http.StatusServiceUnavailable, fmt.Errorf("too many parts are being solved, the limit is %d", s.MaxSolves))
+		return
+	}
+	path, err := s.saveInput(w, r)
+	if err != nil {
+		release()
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeError(w, http.StatusRequestEntityTooLarge, fmt.Errorf("the input is larger than %d bytes", tooLarge.Limit))
+			return
+		}
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	results, err := s.solve(r.Context(), year, day, part, path, release)
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		writeError(w, http.StatusGatewayTimeout, fmt.Errorf("%d day %d part %d: no answer within %v", year, day, part, s.Timeout))
+	case err != nil && r.Context().Err() != nil:
+		// The client is gone, so there is no one to respond to.
+	case err != nil:
+		writeError(w, http.StatusUnprocessableEntity, err)
+	default:
+		status := http.StatusOK
+		if setup.Failed(results) {
+			status = http.StatusUnprocessableEntity
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if err := setup.Report(w, setup.OutputJSON, results); err != nil {
+			setup.Logger(r.Context()).Error("cannot write the result", "error", err)
+		}
+	}
+}
+
+// saveInput writes the body of the request to a temporary file, because a solver reads its input from a file, and
+// returns its path. The file must be removed by the caller.
+func (s *Server) saveInput(w http.ResponseWriter, r *http.Request) (string, error) {
+	body := http.MaxBytesReader(w, r.Body, s.MaxInput)
+	f, err := os.CreateTemp("", "aoc-input-*.txt")
+	if err != nil {
+		return "", err
+	}
+	_, err = io.Copy(f, body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// acquire takes a slot for solving a part, if one is free, and returns the function that gives it back. The slots are
+// made by the first call, so MaxSolves must not be changed after the server has handled a request.
+func (s *Server) acquire() (release func(), ok bool) {
+	s.slotsOnce.Do(func() {
+		if s.MaxSolves > 0 {
+			s.slots = make(chan struct{}, s.MaxSolves)
+		}
+	})
+	if s.slots == nil {
+		return func() {}, true
+	}
+	select {
+	case s.slots <- struct{}{}:
+		return func() { <-s.slots }, true
+	default:
+		return nil, false
+	}
+}
+
+// solve runs the part of the day for the input file at path, which it removes when the run is done, and then calls
+// release. It returns context.DeadlineExceeded if the run takes longer than the timeout of the server, even if the
+// solver does not stop when its context is done, in which case the run continues in the background until it returns.
+func (s *Server) solve(ctx context.Context, year int, day int, part int, path string, release func()) ([]setup.Result, error) {
+	type outcome struct {
+		results []setup.Result
+		err     error
+	}
+	done := make(chan outcome, 1)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		defer release()
+		defer os.Remove(path)
+		results, err := setup.Run(ctx, day, setup.Params{Year: year, Path: path, Parts: []int{part}, Timeout: s.Timeout})
+		done <- outcome{results, err}
+	}()
+
+	var expired <-chan time.Time
+	if s.Timeout > 0 {
+		timer := time.NewTimer(s.Timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+	select {
+	case o := <-done:
+		if len(o.results) == 1 && errors.Is(o.results[0].Err, context.DeadlineExceeded) {
+			return nil, context.DeadlineExceeded
+		}
+		return o.results, o.err
+	case <-expired:
+		return nil, context.DeadlineExceeded
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// writeError writes the error as a JSON object with the given status.
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(struct {
+		Error string `json:"error"`
+	}{err.Error()})
+}
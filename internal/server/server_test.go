@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+// fakeSolver answers part 1 with the length of its input and part 2 with an error.
+type fakeSolver struct {
+	input []byte
+}
+
+func (s *fakeSolver) Parse(path string) (err error) {
+	s.input, err = os.ReadFile(path)
+	if len(s.input) == 0 {
+		return errors.New("empty input")
+	}
+	return err
+}
+func (s *fakeSolver) Part1(ctx context.Context) (any, error) { return len(s.input), nil }
+func (s *fakeSolver) Part2(ctx context.Context) (any, error) { return nil, errors.New("no answer") }
+func (s *fakeSolver) Tags() []string                         { return []string{"Brute force"} }
+
+// slowSolver takes a long time to answer and does not stop when its context is done.
+type slowSolver struct{}
+
+func (slowSolver) Parse(path string) error { return nil }
+func (slowSolver) Part1(ctx context.Context) (any, error) {
+	time.Sleep(time.Second)
+	return 1, nil
+}
+func (slowSolver) Part2(ctx context.Context) (any, error) { return nil, setup.ErrNoPart }
+
+// testYear is a year that no real solver is registered for.
+const testYear = 1
+
+func init() {
+	setup.Register(testYear, 1, func() setup.Solver { return &fakeSolver{} })
+	setup.Register(testYear, 2, func() setup.Solver { return slowSolver{} })
+}
+
+// post sends a solve request with the given body to the server and returns the response.
+func post(t *testing.T, s *Server, target string, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	r := httptest.NewRequestWithContext(t.Context(), http.MethodPost, target, strings.NewReader(body))
+	w := httptest.NewRecorder()
+	s.Handler().ServeHTTP(w, r)
+	return w
+}
+
+// response is the JSON body of a response to a solve request.
+type response struct {
+	Day     int    `json:"day"`
+	Part    int    `json:"part"`
+	Answer  any    `json:"answer"`
+	SolveMs any    `json:"solve_ms"`
+	Error   string `json:"error"`
+}
+
+func decode(t *testing.T, w *httptest.ResponseRecorder) response {
+	t.Helper()
+	var r response
+	if err := json.NewDecoder(w.Body).Decode(&r); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestIndex(t *testing.T) {
+	r := httptest.NewRequestWithContext(t.Context(), http.MethodGet, "/", nil)
+	w := httptest.NewRecorder()
+	New(testYear, 0).Handler().ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.Code)
+	}
+	page := w.Body.String()
+	for _, s := range []string{`href="https://adventofcode.com/1/day/1"`, "Brute force", "/solve/"} {
+		if !strings.Contains(page, s) {
+			t.Errorf("expected the page to contain %q", s)
+		}
+	}
+}
+
+func TestSolve(t *testing.T) {
+	w := post(t, New(testYear, 0), "/solve/1/1", "abcde")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", w.Code, w.Body)
+	}
+	if r := decode(t, w); r.Day != 1 || r.Part != 1 || r.Answer != 5.0 || r.SolveMs == nil || r.Error != "" {
+		t.Errorf("unexpected response %+v", r)
+	}
+
+	// The year is selected by the query.
+	if w := post(t, New(2015, 0), "/solve/1/1?year=1", "abc"); w.Code != http.StatusOK {
+		t.Errorf("expected status 200, got %d: %s", w.Code, w.Body)
+	}
+}
+
+func TestSolve_Errors(t *testing.T) {
+	tests := []struct {
+		target string
+		body   string
+		status int
+	}{
+		{"/solve/1/3", "abc", http.StatusBadRequest},
+		{"/solve/x/1", "abc", http.StatusBadRequest},
+		{"/solve/1/1?year=x", "abc", http.StatusBadRequest},
+		{"/solve/9/1", "abc", http.StatusNotFound},
+		{"/solve/1/1", "", http.StatusUnprocessableEntity},
+		{"/solve/1/2", "abc", http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		w := post(t, New(testYear, 0), tt.target, tt.body)
+		if w.Code != tt.status {
+			t.Errorf("%s: expected status %d, got %d", tt.target, tt.status, w.Code)
+			continue
+		}
+		if r := decode(t, w); r.Error == "" {
+			t.Errorf("%s: expected an error in %+v", tt.target, r)
+		}
+	}
+}
+
+func TestSolve_Timeout(t *testing.T) {
+	start := time.Now()
+	w := post(t, New(testYear, 10*time.Millisecond), "/solve/2/1", "abc")
+	if w.Code != http.StatusGatewayTimeout {
+		t.Fatalf("expected status 504, got %d: %s", w.Code, w.Body)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected the response before the solver returned, took %v", elapsed)
+	}
+}
+
+func TestSolve_TooLarge(t *testing.T) {
+	s := New(testYear, 0)
+	s.MaxInput = 4
+	if w := post(t, s, "/solve/1/1", "abcde"); w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status 413, got %d: %s", w.Code, w.Body)
+	}
+}
+
+func TestSolve_Busy(t *testing.T) {
+	s := New(testYear, 10*time.Millisecond)
+	s.MaxSolves = 1
+	if w := post(t, s, "/solve/2/1", "abc"); w.Code != http.StatusGatewayTimeout {
+		t.Fatalf("expected status 504, got %d: %s", w.Code, w.Body)
+	}
+
+	// The slow solver keeps its slot after the timeout until it returns.
+	if w := post(t, s, "/solve/1/1", "abc"); w.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected status 503, got %d: %s", w.Code, w.Body)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		w := post(t, s, "/solve/1/1", "abc")
+		if w.Code == http.StatusOK {
+			break
+		}
+		if w.Code != http.StatusServiceUnavailable || time.Now().After(deadline) {
+			t.Fatalf("expected status 200 after the slow solver returned, got %d: %s", w.Code, w.Body)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
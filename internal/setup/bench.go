@@ -0,0 +1,231 @@
+package setup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"slices"
+	"time"
+)
+
+// benchWarmup is the number of untimed runs before the timed runs of a benchmark.
+const benchWarmup = 1
+
+// Stats summarizes the repeated measurements of parsing the input of a day or solving one of its parts.
+type Stats struct {
+	Year   int
+	Day    int
+	Part   int // Part that is measured, or 0 for parsing the input
+	Runs   int
+	Min    time.Duration
+	Median time.Duration
+	P95    time.Duration
+	Allocs uint64 // Mean number of heap allocations per run
+	Bytes  uint64 // Mean number of bytes allocated per run
+	Err    error  // Error of the first run that failed, which ends the measurement
+}
+
+// Bench measures parsing the input of the given day of params.Year and solving each of the parts in params.Parts, runs
+// times each after a warm-up. Every run uses a new solver so that a part cannot reuse the work of a previous run. An
+// error is returned if the day is not registered or the input cannot be parsed. Otherwise, the error of each part is
+// reported in its stats. A panic of the solver is reported as a PanicError. Each run stops when ctx is done or params.Timeout has elapsed.
+func Bench(ctx context.Context, day int, params Params, runs int) ([]Stats, error) {
+	if runs < 1 {
+		return nil, fmt.Errorf("invalid number of runs %d", runs)
+	}
+	year := params.year()
+	path := inputPath(year, day, params)
+
+	parseStats := Stats{Year: year, Day: day}
+	measure(&parseStats, runs, func() error {
+		_, err := parse(ctx, year, day, path, params.Overrides)
+		return err
+	})
+	if parseStats.Err != nil {
+		return nil, parseStats.Err
+	}
+
+	stats := []Stats{parseStats}
+	for _, part := range params.Parts {
+		s := Stats{Year: year, Day: day, Part: part}
+		partCtx := WithLogger(ctx, logger.With("year", year, "day", day, "part", part))
+		var solver Solver
+		measure(&s, runs, func() error {
+			ctx, cancel := withTimeout(partCtx, params.Timeout)
+			defer cancel()
+			return protect(ctx, func() error {
+				_, err := Solve(ctx, solver, part)
+				return err
+			})
+		}, func() (err error) {
+			solver, err = parse(partCtx, year, day, path, params.Overrides)
+			return err
+		})
+		if s.Err != nil {
+			s.Err = fmt.Errorf("%d day %d part %d: %w", year, day, part, s.Err)
+		}
+		stats = append(stats, s)
+	}
+	return stats, nil
+}
+
+// measure calls f runs times after a warm-up and records the wall time and allocations of the calls in s. The optional
+// prepare functions are called before each call of f and are not measured. The measurement stops at the first error.
+func measure(s *Stats, runs int, f func() error, prepare ...func() error) {
+	times := make([]time.Duration, 0, runs)
+	var before, after runtime.MemStats
+	for i := range benchWarmup + runs {
+		for _, p := range prepare {
+			if err := p(); err != nil {
+				s.Err = err
+				return
+			}
+		}
+		runtime.ReadMemStats(&before)
+		start := time.Now()
+		err := f()
+		elapsed := time.Since(start)
+		runtime.ReadMemStats(&after)
+		if err != nil {
+			s.Err = err
+			return
+		}
+		if i < benchWarmup {
+			continue
+		}
+		times = append(times, elapsed)
+		s.Allocs += after.Mallocs - before.Mallocs
+		s.Bytes += after.TotalAlloc - before.TotalAlloc
+	}
+
+	slices.Sort(times)
+	s.Runs = runs
+	s.Min = times[0]
+	s.Median = median(times)
+	s.P95 = percentile(times, 95)
+	s.Allocs /= uint64(runs)
+	s.Bytes /= uint64(runs)
+}
+
+// median returns the median of the sorted durations.
+func median(sorted []time.Duration) time.Duration {
+	n := len(sorted)
+	if n%2 == 1 {
+		return sorted[n/2]
+	}
+	return (sorted[n/2-1] + sorted[n/2]) / 2
+}
+
+// percentile returns the p-th percentile of the sorted durations using the nearest-rank method.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+// jsonStats is the JSON representation of stats.
+type jsonStats struct {
+	Year     int     `json:"year"`
+	Day      int     `json:"day"`
+	Part     int     `json:"part"`
+	Runs     int     `json:"runs"`
+	MinMs    float64 `json:"min_ms"`
+	MedianMs float64 `json:"median_ms"`
+	P95Ms    float64 `json:"p95_ms"`
+	Allocs   uint64  `json:"allocs"`
+	Bytes    uint64  `json:"bytes"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// benchOmitted returns true if the stats are not reported because multiple parts are measured and the puzzle does not
+// have the part.
+func benchOmitted(stats []Stats, s Stats) bool {
+	return len(stats) > 2 && errors.Is(s.Err, ErrNoPart)
+}
+
+// BenchFailed returns true if any of the stats of a benchmark of one day is an error. When multiple parts are
+// measured, a part that the puzzle does not have is not an error.
+func BenchFailed(stats []Stats) bool {
+	for _, s := range stats {
+		if s.Err != nil && !benchOmitted(stats, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReportBench writes the stats of a benchmark of one day to w in the given output format.
+func ReportBench(w io.Writer, format string, stats []Stats) error {
+	switch format {
+	case OutputText:
+		return writeBenchText(w, stats)
+	case OutputJSON:
+		return writeBenchJSON(w, stats)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// writeBenchText writes the stats as a table under the banner of the day.
+func writeBenchText(w io.Writer, stats []Stats) error {
+	if len(stats) == 0 {
+		return nil
+	}
+	if err := banner(w, stats[0].Year, stats[0].Day, 0); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "%-6s %12s %12s %12s %10s %12s\n", "", "min", "median", "p95", "allocs", "bytes"); err != nil {
+		return err
+	}
+	for _, s := range stats {
+		if benchOmitted(stats, s) {
+			continue
+		}
+		name := "Parse"
+		if s.Part != 0 {
+			name = fmt.Sprintf("Part %d", s.Part)
+		}
+		var err error
+		if s.Err != nil {
+			_, err = fmt.Fprintf(w, "%-6s error: %v\n", name, s.Err)
+		} else {
+			_, err = fmt.Fprintf(w, "%-6s %12v %12v %12v %10d %12d\n", name,
+				s.Min.Round(time.Microsecond), s.Median.Round(time.Microsecond), s.P95.Round(time.Microsecond),
+				s.Allocs, s.Bytes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeBenchJSON writes each of the stats as a JSON object on its own line.
+func writeBenchJSON(w io.Writer, stats []Stats) error {
+	encoder := json.NewEncoder(w)
+	for _, s := range stats {
+		if benchOmitted(stats, s) {
+			continue
+		}
+		js := jsonStats{
+			Year:     s.Year,
+			Day:      s.Day,
+			Part:     s.Part,
+			Runs:     s.Runs,
+			MinMs:    milliseconds(s.Min),
+			MedianMs: milliseconds(s.Median),
+			P95Ms:    milliseconds(s.P95),
+			Allocs:   s.Allocs,
+			Bytes:    s.Bytes,
+		}
+		if s.Err != nil {
+			js.Error = s.Err.Error()
+		}
+		if err := encoder.Encode(js); err != nil {
+			return err
+		}
+	}
+	return nil
+}
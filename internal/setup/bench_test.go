@@ -0,0 +1,150 @@
+package setup
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		sorted   []time.Duration
+		expected time.Duration
+	}{
+		{[]time.Duration{5}, 5},
+		{[]time.Duration{1, 2, 9}, 2},
+		{[]time.Duration{1, 2, 4, 9}, 3},
+	}
+	for _, tt := range tests {
+		if got := median(tt.sorted); got != tt.expected {
+			t.Errorf("%v: expected %v, got %v", tt.sorted, tt.expected, got)
+		}
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	sorted := make([]time.Duration, 100)
+	for i := range sorted {
+		sorted[i] = time.Duration(i + 1)
+	}
+	if got := percentile(sorted, 95); got != 95 {
+		t.Errorf("expected 95, got %v", got)
+	}
+	if got := percentile(sorted[:1], 95); got != 1 {
+		t.Errorf("expected 1, got %v", got)
+	}
+	if got := percentile(sorted[:10], 95); got != 10 {
+		t.Errorf("expected 10, got %v", got)
+	}
+}
+
+func TestBench(t *testing.T) {
+	withRegistry(t)
+	parses := 0
+	Register(2015, 7, func() Solver { parses++; return &countingSolver{} })
+
+	stats, err := Bench(t.Context(), 7, Params{Path: "input.txt", Parts: []int{1, 2}}, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 3 {
+		t.Fatalf("expected 3 stats, got %d", len(stats))
+	}
+	for i, s := range stats {
+		if s.Day != 7 || s.Part != i || s.Runs != 5 || s.Err != nil {
+			t.Errorf("unexpected stats: %+v", s)
+		}
+		if s.Min > s.Median || s.Median > s.P95 {
+			t.Errorf("part %d: expected min <= median <= p95, got %v, %v, %v", s.Part, s.Min, s.Median, s.P95)
+		}
+	}
+	// Each of the parse and the two parts uses a new solver for the warm-up and every timed run.
+	if expected := 3 * (benchWarmup + 5); parses != expected {
+		t.Errorf("expected %d solvers, got %d", expected, parses)
+	}
+}
+
+func TestBench_Errors(t *testing.T) {
+	withRegistry(t)
+	parseErr := errors.New("bad input")
+	Register(2015, 7, func() Solver { return &fakeSolver{parseErr: parseErr} })
+	Register(2015, 8, newFake)
+
+	if _, err := Bench(t.Context(), 7, Params{Parts: []int{1}}, 3); !errors.Is(err, parseErr) {
+		t.Errorf("expected parse error, got %v", err)
+	}
+	if _, err := Bench(t.Context(), 8, Params{Parts: []int{1}}, 0); err == nil {
+		t.Error("expected error for no runs")
+	}
+
+	stats, err := Bench(t.Context(), 8, Params{Parts: []int{1, 2}}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !errors.Is(stats[2].Err, ErrNoPart) {
+		t.Errorf("part 2: expected ErrNoPart, got %v", stats[2].Err)
+	}
+	if BenchFailed(stats) {
+		t.Error("expected a missing part not to fail when multiple parts are measured")
+	}
+	if !BenchFailed([]Stats{stats[0], stats[2]}) {
+		t.Error("expected a missing part to fail when it is the only part measured")
+	}
+}
+
+func TestBench_Panics(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 1, func() Solver { return &panickingSolver{step: "parse"} })
+	Register(2015, 2, func() Solver { return &panickingSolver{step: "1"} })
+
+	var pe *PanicError
+	if _, err := Bench(t.Context(), 1, Params{Path: "input.txt", Parts: []int{1}}, 3); !errors.As(err, &pe) {
+		t.Errorf("expected a panic error, got %v", err)
+	}
+
+	stats, err := Bench(t.Context(), 2, Params{Path: "input.txt", Parts: []int{1, 2}}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !errors.As(stats[1].Err, &pe) || len(pe.Stack) == 0 {
+		t.Errorf("part 1: expected a panic error with a stack, got %v", stats[1].Err)
+	}
+	if stats[2].Err != nil || stats[2].Runs != 3 {
+		t.Errorf("part 2: expected 3 runs, got %+v", stats[2])
+	}
+}
+
+func TestReportBench_Text(t *testing.T) {
+	stats := []Stats{
+		{Year: 2015, Day: 8, Runs: 3, Min: time.Millisecond, Median: 2 * time.Millisecond, P95: 3 * time.Millisecond, Allocs: 4},
+		{Year: 2015, Day: 8, Part: 1, Runs: 3, Min: time.Second, Median: time.Second, P95: time.Second, Bytes: 1024},
+		{Year: 2015, Day: 8, Part: 2, Err: ErrNoPart},
+	}
+	var buf bytes.Buffer
+	if err := ReportBench(&buf, OutputText, stats); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{"=== 2015 Day 8 ===", "Parse", "1ms", "Part 1", "1s", "1024"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Part 2") {
+		t.Errorf("expected the missing part to be omitted:\n%s", out)
+	}
+}
+
+func TestReportBench_JSON(t *testing.T) {
+	stats := []Stats{{Year: 2015, Day: 8, Part: 1, Runs: 3, Min: time.Millisecond, Median: time.Millisecond, P95: time.Millisecond}}
+	var buf bytes.Buffer
+	if err := ReportBench(&buf, OutputJSON, stats); err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"year":2015,"day":8,"part":1,"runs":3,"min_ms":1,"median_ms":1,"p95_ms":1,"allocs":0,"bytes":0}` + "\n"
+	if buf.String() != expected {
+		t.Errorf("expected %s, got %s", expected, buf.String())
+	}
+}
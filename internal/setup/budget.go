@@ -0,0 +1,56 @@
+package setup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// budgetInterval is the number of states between checks of the context of a budget.
+const budgetInterval = 1024
+
+// Budget counts the states explored by a search and stops the search when its context is done.
+type Budget struct {
+	ctx    context.Context
+	states int
+}
+
+// NewBudget returns a budget that stops a search when ctx is done.
+func NewBudget(ctx context.Context) *Budget {
+	return &Budget{ctx: ctx}
+}
+
+// Step counts one more state. It returns a *StoppedError if the context of the budget is done. The context is checked
+// only every so often, so a search may explore a few more states after the context is done.
+func (b *Budget) Step() error {
+	b.states++
+	if b.states%budgetInterval != 0 {
+		return nil
+	}
+	if err := b.ctx.Err(); err != nil {
+		return &StoppedError{States: b.states, Err: err}
+	}
+	return nil
+}
+
+// States returns the number of states counted so far.
+func (b *Budget) States() int {
+	return b.states
+}
+
+// StoppedError is returned by a search that is stopped because its context is done.
+type StoppedError struct {
+	States int   // Number of states explored before the search stopped
+	Err    error // Error of the context
+}
+
+func (e *StoppedError) Error() string {
+	if errors.Is(e.Err, context.DeadlineExceeded) {
+		return fmt.Sprintf("timed out after %d states", e.States)
+	}
+	return fmt.Sprintf("canceled after %d states", e.States)
+}
+
+func (e *StoppedError) Unwrap() error {
+	return e.Err
+}
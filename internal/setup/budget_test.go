@@ -0,0 +1,89 @@
+package setup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBudget_Step(t *testing.T) {
+	budget := NewBudget(t.Context())
+	for range 3 * budgetInterval {
+		if err := budget.Step(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if budget.States() != 3*budgetInterval {
+		t.Fatalf("expected %d states, got %d", 3*budgetInterval, budget.States())
+	}
+}
+
+func TestBudget_TimedOut(t *testing.T) {
+	ctx, cancel := context.WithTimeout(t.Context(), 0)
+	defer cancel()
+	budget := NewBudget(ctx)
+
+	var err error
+	for err == nil {
+		err = budget.Step()
+	}
+	var stopped *StoppedError
+	if !errors.As(err, &stopped) || stopped.States != budgetInterval {
+		t.Fatalf("expected to stop after %d states, got %v", budgetInterval, err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded, got %v", err)
+	}
+	if expected := "timed out after 1024 states"; err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+}
+
+func TestBudget_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	budget := NewBudget(ctx)
+
+	var err error
+	for err == nil {
+		err = budget.Step()
+	}
+	if !errors.Is(err, context.Canceled) || err.Error() != "canceled after 1024 states" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
+// endlessSolver searches forever unless its budget stops it.
+type endlessSolver struct{}
+
+func (s *endlessSolver) Parse(path string) error { return nil }
+
+func (s *endlessSolver) Part1(ctx context.Context) (any, error) {
+	budget := NewBudget(ctx)
+	for {
+		if err := budget.Step(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (s *endlessSolver) Part2(ctx context.Context) (any, error) { return 2, nil }
+
+func TestRun_Timeout(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 7, func() Solver { return &endlessSolver{} })
+
+	results, err := Run(t.Context(), 7, Params{Parts: []int{1, 2}, Timeout: 10 * time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stopped *StoppedError
+	if !errors.As(results[0].Err, &stopped) || !errors.Is(results[0].Err, context.DeadlineExceeded) {
+		t.Errorf("part 1: expected a timeout, got %v", results[0].Err)
+	}
+	// Part 2 does not search, so it still succeeds.
+	if results[1].Err != nil || results[1].Answer != 2 {
+		t.Errorf("part 2: unexpected result %+v", results[1])
+	}
+}
@@ -0,0 +1,80 @@
+package setup
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// LevelTrace is the level of the most detailed events of a solver, such as each state of a search.
+const LevelTrace = slog.LevelDebug - 4
+
+var logger = newLogger(os.Stderr, slog.LevelInfo)
+
+// SetLogging sends the events of the solvers at the given level and above to w.
+func SetLogging(w io.Writer, level slog.Level) {
+	logger = newLogger(w, level)
+}
+
+// newLogger returns a logger that writes events at the given level and above to w as text. The events are not
+// timestamped because they are read as a sequence.
+func newLogger(w io.Writer, level slog.Level) *slog.Logger {
+	options := &slog.HandlerOptions{
+		Level: level,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) > 0 {
+				return a
+			}
+			switch a.Key {
+			case slog.TimeKey:
+				return slog.Attr{}
+			case slog.LevelKey:
+				if a.Value.Any().(slog.Level) == LevelTrace {
+					a.Value = slog.StringValue("TRACE")
+				}
+			}
+			return a
+		},
+	}
+	return slog.New(slog.NewTextHandler(w, options))
+}
+
+type loggerKey struct{}
+
+// WithLogger returns a copy of ctx that carries the logger, which Logger returns to the solver run with it.
+func WithLogger(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// Logger returns the logger of the solver run by ctx, which identifies the day and part of its events. If ctx is not
+// from a run, the shared logger is returned.
+func Logger(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return logger
+}
+
+// levelFlag is the level of the events to log. It can be set without a value, which selects slog.LevelDebug.
+type levelFlag slog.Level
+
+func (l *levelFlag) String() string { return strings.ToLower(slog.Level(*l).String()) }
+
+func (l *levelFlag) Set(value string) error {
+	switch value {
+	case "true", "debug":
+		*l = levelFlag(slog.LevelDebug)
+	case "false", "info":
+		*l = levelFlag(slog.LevelInfo)
+	case "trace":
+		*l = levelFlag(LevelTrace)
+	default:
+		return errors.New("must be debug, trace, or info")
+	}
+	return nil
+}
+
+func (l *levelFlag) IsBoolFlag() bool { return true }
@@ -0,0 +1,88 @@
+package setup
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// withLogging sends the events at the given level and above to the returned buffer for the duration of a test.
+func withLogging(t *testing.T, level slog.Level) *bytes.Buffer {
+	t.Helper()
+	saved := logger
+	var buf bytes.Buffer
+	SetLogging(&buf, level)
+	t.Cleanup(func() { logger = saved })
+	return &buf
+}
+
+func TestLogger_Levels(t *testing.T) {
+	buf := withLogging(t, slog.LevelDebug)
+	log := Logger(t.Context())
+	log.Debug("shown", "states", 3)
+	log.Log(t.Context(), LevelTrace, "hidden")
+
+	expected := "level=DEBUG msg=shown states=3\n"
+	if buf.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestLogger_Trace(t *testing.T) {
+	buf := withLogging(t, LevelTrace)
+	Logger(t.Context()).Log(t.Context(), LevelTrace, "step")
+	if expected := "level=TRACE msg=step\n"; buf.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+// loggingSolver logs an event when it solves a part.
+type loggingSolver struct{}
+
+func (s *loggingSolver) Parse(path string) error { return nil }
+
+func (s *loggingSolver) Part1(ctx context.Context) (any, error) {
+	Logger(ctx).Debug("solving")
+	return 1, nil
+}
+
+func (s *loggingSolver) Part2(ctx context.Context) (any, error) { return nil, ErrNoPart }
+
+func TestRun_LogsDayAndPart(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 7, func() Solver { return &loggingSolver{} })
+	buf := withLogging(t, slog.LevelDebug)
+
+	if _, err := Run(t.Context(), 7, Params{Parts: []int{1}}); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "level=DEBUG msg=solving year=2015 day=7 part=1\n"; buf.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestParameters_LogLevel(t *testing.T) {
+	tests := []struct {
+		args     []string
+		expected slog.Level
+	}{
+		{nil, slog.LevelInfo},
+		{[]string{"-v"}, slog.LevelDebug},
+		{[]string{"-v=debug"}, slog.LevelDebug},
+		{[]string{"-v=trace"}, LevelTrace},
+	}
+	for _, tt := range tests {
+		params, _, err := Parameters(0, tt.args)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.args, err)
+		}
+		if params.LogLevel != tt.expected {
+			t.Errorf("%v: expected %v, got %v", tt.args, tt.expected, params.LogLevel)
+		}
+	}
+	if _, _, err := Parameters(0, []string{"-v=loud"}); err == nil || !strings.Contains(err.Error(), "trace") {
+		t.Errorf("expected error for an invalid level, got %v", err)
+	}
+}
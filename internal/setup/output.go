@@ -0,0 +1,210 @@
+package setup
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+	"time"
+)
+
+// Output formats
+const (
+	OutputText = "text"
+	OutputJSON = "json"
+)
+
+// jsonResult is the JSON representation of a result.
+type jsonResult struct {
+	Year        int     `json:"year"`
+	Day         int     `json:"day"`
+	Part        int     `json:"part"`
+	Answer      any     `json:"answer"`
+	ParseMs     float64 `json:"parse_ms"`
+	SolveMs     float64 `json:"solve_ms"`
+	InputSHA256 string  `json:"input_sha256"`
+	Error       string  `json:"error,omitempty"`
+}
+
+// Failed returns true if any of the results of a run of one day is an error. When multiple parts are run, a part that
+// the puzzle does not have is not an error.
+func Failed(results []Result) bool {
+	for _, r := range results {
+		if r.Err != nil && !omitted(results, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// omitted returns true if the result is not reported because multiple parts are run and the puzzle does not have the
+// part.
+func omitted(results []Result, r Result) bool {
+	return len(results) > 1 && errors.Is(r.Err, ErrNoPart)
+}
+
+// Report writes the results of a run of one day to w in the given output format.
+func Report(w io.Writer, format string, results []Result) error {
+	switch format {
+	case OutputText:
+		return writeText(w, results)
+	case OutputJSON:
+		return writeJSON(w, results)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// ReportAll writes the results of a run of several days, one slice of results for each day, to w in the given output
+// format. The text format is a summary table with one row for each part.
+func ReportAll(w io.Writer, format string, days [][]Result) error {
+	switch format {
+	case OutputText:
+		return writeSummary(w, days)
+	case OutputJSON:
+		for _, results := range days {
+			if err := writeJSON(w, results); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// writeSummary writes a table of the day, part, answer, solve time, and status of each result, sorted by day and part.
+// The status is ok, or the error of the part.
+func writeSummary(w io.Writer, days [][]Result) error {
+	var rows []Result
+	for _, results := range days {
+		for _, r := range results {
+			if !omitted(results, r) {
+				rows = append(rows, r)
+			}
+		}
+	}
+	slices.SortFunc(rows, func(a, b Result) int {
+		return cmp.Or(cmp.Compare(a.Year, b.Year), cmp.Compare(a.Day, b.Day), cmp.Compare(a.Part, b.Part))
+	})
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Day\tPart\tAnswer\tTime\tStatus")
+	for _, r := range rows {
+		answer, status := fmt.Sprint(r.Answer), "ok"
+		if r.Err != nil {
+			answer, status = "", r.Err.Error()
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%v\t%s\n", r.Day, r.Part, answer, r.SolveTime.Round(time.Microsecond), status)
+	}
+	return tw.Flush()
+}
+
+// writeText writes the results as text. A single part is shown with its own banner, and multiple parts are shown
+// together under the banner of the day.
+func writeText(w io.Writer, results []Result) error {
+	if len(results) == 0 {
+		return nil
+	}
+	if len(results) == 1 {
+		r := results[0]
+		if err := banner(w, r.Year, r.Day, r.Part); err != nil {
+			return err
+		}
+		if r.Err != nil {
+			_, err := fmt.Fprintf(w, "Error: %v\n", r.Err)
+			return err
+		}
+		_, err := fmt.Fprintf(w, "Answer: %v\n", r.Answer)
+		return err
+	}
+
+	if err := banner(w, results[0].Year, results[0].Day, 0); err != nil {
+		return err
+	}
+	for _, r := range results {
+		if omitted(results, r) {
+			continue
+		}
+		var err error
+		if r.Err != nil {
+			_, err = fmt.Fprintf(w, "Part %d: error: %v\n", r.Part, r.Err)
+		} else {
+			_, err = fmt.Fprintf(w, "Part %d: %v\n", r.Part, r.Answer)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeJSON writes each result as a JSON object on its own line.
+func writeJSON(w io.Writer, results []Result) error {
+	encoder := json.NewEncoder(w)
+	for _, r := range results {
+		if omitted(results, r) {
+			continue
+		}
+		jr := jsonResult{
+			Year:        r.Year,
+			Day:         r.Day,
+			Part:        r.Part,
+			Answer:      r.Answer,
+			ParseMs:     milliseconds(r.ParseTime),
+			SolveMs:     milliseconds(r.SolveTime),
+			InputSHA256: r.InputSHA256,
+		}
+		if r.Err != nil {
+			jr.Error = r.Err.Error()
+		}
+		if err := encoder.Encode(jr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadJSON reads results written by Report in the JSON format. Numeric answers are read as json.Number so that they
+// print as they were written.
+func ReadJSON(r io.Reader) ([]Result, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	var results []Result
+	for {
+		var jr jsonResult
+		err := decoder.Decode(&jr)
+		if err == io.EOF {
+			return results, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		result := Result{
+			Year:        jr.Year,
+			Day:         jr.Day,
+			Part:        jr.Part,
+			Answer:      jr.Answer,
+			ParseTime:   duration(jr.ParseMs),
+			SolveTime:   duration(jr.SolveMs),
+			InputSHA256: jr.InputSHA256,
+		}
+		if jr.Error != "" {
+			result.Err = errors.New(jr.Error)
+		}
+		results = append(results, result)
+	}
+}
+
+// milliseconds returns the duration in milliseconds.
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// duration returns the duration of the given number of milliseconds.
+func duration(ms float64) time.Duration {
+	return time.Duration(ms * float64(time.Millisecond))
+}
@@ -0,0 +1,180 @@
+package setup
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReport_TextSinglePart(t *testing.T) {
+	var buf bytes.Buffer
+	results := []Result{{Year: 2015, Day: 7, Part: 2, Answer: 14134}}
+	if err := Report(&buf, OutputText, results); err != nil {
+		t.Fatal(err)
+	}
+	expected := "=== 2015 Day 7 - Part 2 ===\nAnswer: 14134\n"
+	if buf.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestReport_TextMultipleParts(t *testing.T) {
+	var buf bytes.Buffer
+	results := []Result{
+		{Year: 2015, Day: 25, Part: 1, Answer: 8997277},
+		{Year: 2015, Day: 25, Part: 2, Err: ErrNoPart},
+	}
+	if err := Report(&buf, OutputText, results); err != nil {
+		t.Fatal(err)
+	}
+	expected := "=== 2015 Day 25 ===\nPart 1: 8997277\n"
+	if buf.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestReport_TextError(t *testing.T) {
+	var buf bytes.Buffer
+	results := []Result{{Day: 3, Part: 1, Err: errors.New("boom")}}
+	if err := Report(&buf, OutputText, results); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "Error: boom") {
+		t.Fatalf("expected error in output, got %q", buf.String())
+	}
+}
+
+func TestReport_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	results := []Result{
+		{Day: 11, Part: 1, Answer: "cqjxxyzz", ParseTime: 2 * time.Millisecond, SolveTime: 1500 * time.Microsecond,
+			InputSHA256: "abc"},
+		{Day: 11, Part: 2, Err: errors.New("boom")},
+	}
+	if err := Report(&buf, OutputJSON, results); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d: %q", len(lines), buf.String())
+	}
+
+	var first map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatal(err)
+	}
+	if first["day"] != 11.0 || first["part"] != 1.0 || first["answer"] != "cqjxxyzz" {
+		t.Errorf("unexpected result: %v", first)
+	}
+	if first["parse_ms"] != 2.0 || first["solve_ms"] != 1.5 || first["input_sha256"] != "abc" {
+		t.Errorf("unexpected timings or hash: %v", first)
+	}
+	if _, ok := first["error"]; ok {
+		t.Errorf("unexpected error field: %v", first)
+	}
+
+	var second map[string]any
+	if err := json.Unmarshal([]byte(lines[1]), &second); err != nil {
+		t.Fatal(err)
+	}
+	if second["error"] != "boom" {
+		t.Errorf("expected error field, got %v", second)
+	}
+}
+
+func TestReport_UnknownFormat(t *testing.T) {
+	if err := Report(&bytes.Buffer{}, "xml", nil); err == nil {
+		t.Fatal("expected error for unknown format")
+	}
+}
+
+func TestFailed(t *testing.T) {
+	ok := Result{Part: 1, Answer: 1}
+	noPart := Result{Part: 2, Err: ErrNoPart}
+	if Failed([]Result{ok, noPart}) {
+		t.Error("a missing part of a multi-part run is not a failure")
+	}
+	if !Failed([]Result{noPart}) {
+		t.Error("a missing part that was requested explicitly is a failure")
+	}
+	if !Failed([]Result{ok, {Part: 2, Err: errors.New("boom")}}) {
+		t.Error("expected failure")
+	}
+}
+
+func TestReportAll_Text(t *testing.T) {
+	days := [][]Result{
+		{
+			{Year: 2015, Day: 25, Part: 1, Answer: 8997277, SolveTime: 1234567 * time.Nanosecond},
+			{Year: 2015, Day: 25, Part: 2, Err: ErrNoPart},
+		},
+		{
+			{Year: 2015, Day: 3, Part: 2, Err: errors.New("2015 day 3 part 2: panic: boom")},
+			{Year: 2015, Day: 3, Part: 1, Answer: 2565, SolveTime: 800 * time.Microsecond},
+		},
+	}
+	var buf bytes.Buffer
+	if err := ReportAll(&buf, OutputText, days); err != nil {
+		t.Fatal(err)
+	}
+	expected := "Day  Part  Answer   Time     Status\n" +
+		"3    1     2565     800µs    ok\n" +
+		"3    2              0s       2015 day 3 part 2: panic: boom\n" +
+		"25   1     8997277  1.235ms  ok\n"
+	if buf.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, buf.String())
+	}
+}
+
+func TestReportAll_JSON(t *testing.T) {
+	days := [][]Result{
+		{{Year: 2015, Day: 1, Part: 1, Answer: 280}},
+		{{Year: 2015, Day: 25, Part: 1, Answer: 8997277}, {Year: 2015, Day: 25, Part: 2, Err: ErrNoPart}},
+	}
+	var buf bytes.Buffer
+	if err := ReportAll(&buf, OutputJSON, days); err != nil {
+		t.Fatal(err)
+	}
+	if lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n"); len(lines) != 2 {
+		t.Errorf("expected 2 lines, got %q", buf.String())
+	}
+}
+
+func TestReadJSON(t *testing.T) {
+	results := []Result{
+		{Year: 2015, Day: 24, Part: 1, Answer: 11266889531, ParseTime: 2 * time.Millisecond, SolveTime: 1500 * time.Microsecond,
+			InputSHA256: "abc"},
+		{Year: 2015, Day: 24, Part: 2, Err: errors.New("boom")},
+	}
+	var buf bytes.Buffer
+	if err := Report(&buf, OutputJSON, results); err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := ReadJSON(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(read) != 2 {
+		t.Fatalf("expected 2 results, got %+v", read)
+	}
+	first := read[0]
+	if first.Year != 2015 || first.Day != 24 || first.Part != 1 || fmt.Sprint(first.Answer) != "11266889531" {
+		t.Errorf("unexpected result %+v", first)
+	}
+	if first.ParseTime != 2*time.Millisecond || first.SolveTime != 1500*time.Microsecond || first.InputSHA256 != "abc" {
+		t.Errorf("unexpected timings or hash %+v", first)
+	}
+	if read[1].Err == nil || read[1].Err.Error() != "boom" {
+		t.Errorf("expected error boom, got %v", read[1].Err)
+	}
+
+	if _, err := ReadJSON(strings.NewReader("{")); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
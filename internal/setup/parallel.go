@@ -0,0 +1,45 @@
+package setup
+
+import (
+	"context"
+	"sync"
+)
+
+// RunAll runs each of the days with Run on a pool of at most jobs goroutines and returns the results of each day in
+// the order of the days. A day that cannot be run has the error in the result of each of its parts, so the failure of
+// one day, including a panic, does not stop the others. The days that have not started when ctx is done are not run.
+func RunAll(ctx context.Context, days []int, params Params, jobs int) [][]Result {
+	results := make([][]Result, len(days))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for range min(max(jobs, 1), len(days)) {
+		wg.Go(func() {
+			for i := range next {
+				results[i] = runDay(ctx, days[i], params)
+			}
+		})
+	}
+	for i := range days {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+	return results
+}
+
+// runDay runs the day with Run and returns its results. If the day cannot be run, the error is the result of each
+// part.
+func runDay(ctx context.Context, day int, params Params) []Result {
+	err := ctx.Err()
+	if err == nil {
+		var results []Result
+		if results, err = Run(ctx, day, params); err == nil {
+			return results
+		}
+	}
+	results := make([]Result, len(params.Parts))
+	for i, part := range params.Parts {
+		results[i] = Result{Year: params.year(), Day: day, Part: part, Err: err}
+	}
+	return results
+}
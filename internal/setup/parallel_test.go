@@ -0,0 +1,84 @@
+package setup
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// concurrencySolver records the most solvers that run at the same time.
+type concurrencySolver struct {
+	mu      *sync.Mutex
+	running *int
+	most    *int
+}
+
+func (s *concurrencySolver) Parse(path string) error { return nil }
+
+func (s *concurrencySolver) Part1(ctx context.Context) (any, error) {
+	s.mu.Lock()
+	*s.running++
+	*s.most = max(*s.most, *s.running)
+	s.mu.Unlock()
+	time.Sleep(10 * time.Millisecond)
+	s.mu.Lock()
+	*s.running--
+	s.mu.Unlock()
+	return 1, nil
+}
+
+func (s *concurrencySolver) Part2(ctx context.Context) (any, error) { return nil, ErrNoPart }
+
+func TestRunAll(t *testing.T) {
+	withRegistry(t)
+	var mu sync.Mutex
+	var running, most int
+	days := []int{1, 2, 3, 4, 5, 6}
+	for _, day := range days {
+		Register(2015, day, func() Solver { return &concurrencySolver{mu: &mu, running: &running, most: &most} })
+	}
+	Register(2015, 7, func() Solver { return &panickingSolver{step: "parse"} })
+	Register(2015, 8, func() Solver { return &panickingSolver{step: "1"} })
+	days = append(days, 7, 8, 9)
+
+	results := RunAll(t.Context(), days, Params{Path: "input.txt", Parts: []int{1, 2}}, 3)
+	if len(results) != len(days) {
+		t.Fatalf("expected results for %d days, got %d", len(days), len(results))
+	}
+	for i, day := range days {
+		if len(results[i]) != 2 || results[i][0].Day != day || results[i][1].Part != 2 {
+			t.Errorf("day %d: unexpected results %+v", day, results[i])
+		}
+	}
+	if most < 2 || most > 3 {
+		t.Errorf("expected at most 3 days to run at the same time, got %d", most)
+	}
+	if Failed(results[0]) {
+		t.Errorf("day 1: unexpected failure %+v", results[0])
+	}
+
+	var pe *PanicError
+	if !errors.As(results[6][0].Err, &pe) || !errors.As(results[6][1].Err, &pe) {
+		t.Errorf("day 7: expected both parts to fail with the panic, got %+v", results[6])
+	}
+	if !errors.As(results[7][0].Err, &pe) || results[7][1].Err != nil {
+		t.Errorf("day 8: expected only part 1 to panic, got %+v", results[7])
+	}
+	if results[8][0].Err == nil || results[8][1].Err == nil {
+		t.Errorf("day 9: expected an unregistered day to fail, got %+v", results[8])
+	}
+}
+
+func TestRunAll_Canceled(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 1, newFake)
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	results := RunAll(ctx, []int{1}, Params{Path: "input.txt", Parts: []int{1}}, 2)
+	if len(results) != 1 || !errors.Is(results[0][0].Err, context.Canceled) {
+		t.Errorf("expected the day not to run, got %+v", results)
+	}
+}
@@ -0,0 +1,78 @@
+package setup
+
+import (
+	"errors"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+)
+
+// Profiles holds the paths of the profiles to write during a run. An empty path disables its profile.
+type Profiles struct {
+	CPU   string // Path of the CPU profile
+	Mem   string // Path of the heap profile, which is written when profiling stops
+	Trace string // Path of the execution trace
+}
+
+// Start starts the CPU profile and the execution trace. The returned function stops them and writes the heap profile.
+// It must be called once the work to profile is done.
+func (p Profiles) Start() (stop func() error, err error) {
+	var stops []func() error
+	stopAll := func() error {
+		var errs []error
+		for i := len(stops) - 1; i >= 0; i-- {
+			errs = append(errs, stops[i]())
+		}
+		return errors.Join(errs...)
+	}
+
+	if p.CPU != "" {
+		f, err := os.Create(p.CPU)
+		if err != nil {
+			return nil, err
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, err
+		}
+		stops = append(stops, func() error {
+			pprof.StopCPUProfile()
+			return f.Close()
+		})
+	}
+
+	if p.Trace != "" {
+		f, err := os.Create(p.Trace)
+		if err != nil {
+			return nil, errors.Join(err, stopAll())
+		}
+		if err := trace.Start(f); err != nil {
+			f.Close()
+			return nil, errors.Join(err, stopAll())
+		}
+		stops = append(stops, func() error {
+			trace.Stop()
+			return f.Close()
+		})
+	}
+
+	if p.Mem != "" {
+		stops = append(stops, func() error { return writeHeapProfile(p.Mem) })
+	}
+	return stopAll, nil
+}
+
+// writeHeapProfile writes a profile of the live heap to the file at path.
+func writeHeapProfile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	runtime.GC() // Update the statistics of the heap
+	if err := pprof.WriteHeapProfile(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,65 @@
+package setup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProfiles_Start(t *testing.T) {
+	dir := t.TempDir()
+	p := Profiles{
+		CPU:   filepath.Join(dir, "cpu.out"),
+		Mem:   filepath.Join(dir, "mem.out"),
+		Trace: filepath.Join(dir, "trace.out"),
+	}
+	stop, err := p.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stop(); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{p.CPU, p.Mem, p.Trace} {
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Error(err)
+		} else if info.Size() == 0 {
+			t.Errorf("%s: expected a profile", filepath.Base(path))
+		}
+	}
+}
+
+func TestProfiles_None(t *testing.T) {
+	stop, err := Profiles{}.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProfiles_InvalidPath(t *testing.T) {
+	dir := t.TempDir()
+	missing := filepath.Join(dir, "missing", "out")
+
+	// The CPU profile must be stopped if the trace cannot be started, or the next test to start one fails.
+	p := Profiles{CPU: filepath.Join(dir, "cpu.out"), Trace: missing}
+	if _, err := p.Start(); err == nil {
+		t.Fatal("expected error for an invalid trace path")
+	}
+	stop, err := Profiles{CPU: filepath.Join(dir, "cpu2.out")}.Start()
+	if err != nil {
+		t.Fatalf("expected the CPU profile to be stopped after the error: %v", err)
+	}
+	stop()
+
+	stop, err = Profiles{Mem: missing}.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := stop(); err == nil {
+		t.Fatal("expected error for an invalid heap profile path")
+	}
+}
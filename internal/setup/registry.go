@@ -0,0 +1,295 @@
+package setup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime/debug"
+	"slices"
+	"time"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+)
+
+// Solver is implemented by the solution of each day.
+type Solver interface {
+	// Parse reads and parses the puzzle input in the file at path.
+	Parse(path string) error
+	// Part1 returns the answer to part 1 of the puzzle. A long search should stop when ctx is done.
+	Part1(ctx context.Context) (any, error)
+	// Part2 returns the answer to part 2 of the puzzle. A long search should stop when ctx is done.
+	Part2(ctx context.Context) (any, error)
+}
+
+// Tagged is implemented by a solver that declares the algorithms and techniques that it uses, which are listed in the
+// summary of the README.
+type Tagged interface {
+	// Tags returns the names of the algorithms and techniques, such as "A* search".
+	Tags() []string
+}
+
+// ErrNoPart is returned by a solver for a part that the puzzle does not have, such as part 2 of day 25.
+var ErrNoPart = errors.New("the puzzle has no such part")
+
+// puzzle identifies the puzzle of one day of one year.
+type puzzle struct {
+	year int
+	day  int
+}
+
+var solvers = make(map[puzzle]func() Solver)
+
+// Register adds the constructor of the solver for the given day of the given year to the registry. It panics if the
+// day is already registered.
+func Register(year int, day int, newSolver func() Solver) {
+	if _, ok := solvers[puzzle{year, day}]; ok {
+		panic(fmt.Sprintf("setup: %d day %d is already registered", year, day))
+	}
+	solvers[puzzle{year, day}] = newSolver
+}
+
+// Lookup returns a new solver for the given day of the given year.
+func Lookup(year int, day int) (Solver, bool) {
+	newSolver, ok := solvers[puzzle{year, day}]
+	if !ok {
+		return nil, false
+	}
+	return newSolver(), true
+}
+
+// Days returns the registered days of the given year in ascending order.
+func Days(year int) []int {
+	var days []int
+	for p := range solvers {
+		if p.year == year {
+			days = append(days, p.day)
+		}
+	}
+	slices.Sort(days)
+	return days
+}
+
+// Years returns the years with registered days in ascending order.
+func Years() []int {
+	var years []int
+	for p := range solvers {
+		if !slices.Contains(years, p.year) {
+			years = append(years, p.year)
+		}
+	}
+	slices.Sort(years)
+	return years
+}
+
+// DefaultYear returns the latest year with registered days, or 0 if no days are registered.
+func DefaultYear() int {
+	years := Years()
+	if len(years) == 0 {
+		return 0
+	}
+	return years[len(years)-1]
+}
+
+// Solve returns the answer of the solver to the given part. The input must already be parsed.
+func Solve(ctx context.Context, solver Solver, part int) (any, error) {
+	switch part {
+	case 1:
+		return solver.Part1(ctx)
+	case 2:
+		return solver.Part2(ctx)
+	default:
+		return nil, fmt.Errorf("invalid part %d", part)
+	}
+}
+
+// Result is the outcome of solving one part of a day's puzzle.
+type Result struct {
+	Year        int
+	Day         int
+	Part        int
+	Answer      any
+	Err         error
+	ParseTime   time.Duration // Time spent parsing the input, which is shared by all parts of a run
+	SolveTime   time.Duration // Time spent solving the part
+	InputSHA256 string        // Hex SHA-256 of the input file, or empty if the day does not read one
+}
+
+// Run parses the input once, applies the overrides, and then solves each of the parts in order using the solver
+// registered for the given day of params.Year. If params.Path is empty, the input file of the selected example, or else
+// the default input file for the day, is used. Both are in the data directory params.DataDir. An error is returned if
+// the day is not registered, the input cannot be parsed, or the settings are invalid after params.Overrides are applied.
+// The input is not needed, so it may be missing or invalid, if params.Overrides set all of the flags of the solver.
+// Otherwise, the error of each part is reported in its result. A panic of the solver is returned as a PanicError. The
+// run stops when ctx is done or params.Timeout has elapsed.
+func Run(ctx context.Context, day int, params Params) ([]Result, error) {
+	ctx, cancel := withTimeout(ctx, params.Timeout)
+	defer cancel()
+	year := params.year()
+	path := inputPath(year, day, params)
+	start := time.Now()
+	solver, err := parse(WithLogger(ctx, logger.With("year", year, "day", day)), year, day, path, params.Overrides)
+	if err != nil {
+		return nil, err
+	}
+	parseTime := time.Since(start)
+	inputSHA256 := hashFile(path)
+
+	results := make([]Result, 0, len(params.Parts))
+	for _, part := range params.Parts {
+		partCtx := WithLogger(ctx, logger.With("year", year, "day", day, "part", part))
+		start := time.Now()
+		var answer any
+		err := protect(partCtx, func() (err error) {
+			answer, err = Solve(partCtx, solver, part)
+			return err
+		})
+		solveTime := time.Since(start)
+		if err != nil {
+			err = fmt.Errorf("%d day %d part %d: %w", year, day, part, err)
+		}
+		results = append(results, Result{
+			Year:        year,
+			Day:         day,
+			Part:        part,
+			Answer:      answer,
+			Err:         err,
+			ParseTime:   parseTime,
+			SolveTime:   solveTime,
+			InputSHA256: inputSHA256,
+		})
+	}
+	return results, nil
+}
+
+// PanicError is the error of a solver that panicked.
+type PanicError struct {
+	Value any    // Value passed to panic
+	Stack []byte // Stack trace of the goroutine that panicked
+}
+
+func (e *PanicError) Error() string { return fmt.Sprintf("panic: %v", e.Value) }
+
+// protect calls f and returns a PanicError if it panics, so that a panic of a solver does not stop the other runs. The
+// stack trace is logged at debug level to the logger of ctx.
+func protect(ctx context.Context, f func() error) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			pe := &PanicError{Value: v, Stack: debug.Stack()}
+			Logger(ctx).Debug("solver panicked", "panic", v, "stack", string(pe.Stack))
+			err = pe
+		}
+	}()
+	return f()
+}
+
+// withTimeout returns a copy of ctx that is done when the timeout elapses, or that has no deadline if the timeout is 0.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// inputPath returns the path of the input file of the given day of the given year selected by params.
+func inputPath(year int, day int, params Params) string {
+	dir := params.DataDir
+	if dir == "" {
+		dir = DataDir()
+	}
+	switch {
+	case params.Path != "":
+		return params.Path
+	case params.Example != 0:
+		return ExamplePath(dir, year, day, params.Example)
+	default:
+		return DefaultPath(dir, year, day)
+	}
+}
+
+// parse returns a new solver for the given day of the given year that has parsed the input file at path and applied
+// the overrides. The settings of a Configurable solver are validated after the overrides are applied, so that an
+// override can replace an invalid value of the input. If the overrides set all of its flags, the input is not needed,
+// so it may be missing or invalid.
+func parse(ctx context.Context, year int, day int, path string, overrides map[string]string) (Solver, error) {
+	solver, ok := Lookup(year, day)
+	if !ok {
+		return nil, fmt.Errorf("no solver registered for %d day %d", year, day)
+	}
+	err := protect(ctx, func() error { return solver.Parse(path) })
+	if err != nil && !overridesAll(solver, overrides) {
+		return nil, fmt.Errorf("%d day %d: %w", year, day, err)
+	}
+	if err := applyOverrides(solver, overrides); err != nil {
+		return nil, fmt.Errorf("%d day %d: %w", year, day, err)
+	}
+	if c, ok := solver.(Configurable); ok {
+		if err := c.Validate(); err != nil {
+			return nil, fmt.Errorf("%d day %d: %w", year, day, err)
+		}
+	}
+	return solver, nil
+}
+
+// overridesAll returns true if the solver is Configurable and the overrides set all of its flags.
+func overridesAll(solver Solver, overrides map[string]string) bool {
+	c, ok := solver.(Configurable)
+	if !ok {
+		return false
+	}
+	flags := flag.NewFlagSet("solver", flag.ContinueOnError)
+	c.Flags(flags)
+	all := true
+	flags.VisitAll(func(f *flag.Flag) {
+		if _, ok := overrides[f.Name]; !ok {
+			all = false
+		}
+	})
+	return all
+}
+
+// applyOverrides sets the flags of the solver to the given values.
+func applyOverrides(solver Solver, overrides map[string]string) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+	c, ok := solver.(Configurable)
+	if !ok {
+		return errors.New("the solver has no settings to override")
+	}
+	flags := flag.NewFlagSet("solver", flag.ContinueOnError)
+	c.Flags(flags)
+	for name, value := range overrides {
+		if err := flags.Set(name, value); err != nil {
+			return fmt.Errorf("invalid value %q for flag -%s: %w", value, name, err)
+		}
+	}
+	return nil
+}
+
+// hashFile returns the hex SHA-256 of the content of the file at path, or an empty string if it cannot be read. A file
+// is hashed as it is read, so that a large input is not held in memory. The standard input is already held by load.
+func hashFile(path string) string {
+	h := sha256.New()
+	if path == load.Stdin {
+		data, err := load.Read(path)
+		if err != nil {
+			return ""
+		}
+		h.Write(data)
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return ""
+		}
+		defer f.Close()
+		if _, err := io.Copy(h, f); err != nil {
+			return ""
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,441 @@
+package setup
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// fakeSolver records the path it parses and answers with the part number.
+type fakeSolver struct {
+	path     string
+	parseErr error
+}
+
+func (s *fakeSolver) Parse(path string) error {
+	s.path = path
+	return s.parseErr
+}
+
+func (s *fakeSolver) Part1(ctx context.Context) (any, error) { return "one:" + s.path, nil }
+func (s *fakeSolver) Part2(ctx context.Context) (any, error) { return nil, ErrNoPart }
+
+// withRegistry replaces the registry for the duration of a test.
+func withRegistry(t *testing.T) {
+	t.Helper()
+	saved := solvers
+	solvers = make(map[puzzle]func() Solver)
+	t.Cleanup(func() { solvers = saved })
+}
+
+func newFake() Solver { return &fakeSolver{} }
+
+func TestRegister_Lookup(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 3, newFake)
+
+	solver, ok := Lookup(2015, 3)
+	if !ok {
+		t.Fatal("expected day 3 to be registered")
+	}
+	if _, ok := solver.(*fakeSolver); !ok {
+		t.Fatalf("unexpected solver type %T", solver)
+	}
+	if _, ok := Lookup(2015, 4); ok {
+		t.Fatal("expected day 4 to be unregistered")
+	}
+}
+
+func TestLookup_ReturnsNewSolver(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 3, newFake)
+	a, _ := Lookup(2015, 3)
+	b, _ := Lookup(2015, 3)
+	if a == b {
+		t.Fatal("expected a new solver for each lookup")
+	}
+}
+
+func TestRegister_DuplicatePanics(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 1, newFake)
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for duplicate registration")
+		}
+	}()
+	Register(2015, 1, newFake)
+}
+
+func TestDays_Sorted(t *testing.T) {
+	withRegistry(t)
+	for _, day := range []int{12, 3, 25, 1} {
+		Register(2015, day, newFake)
+	}
+	if days := Days(2015); !slices.Equal(days, []int{1, 3, 12, 25}) {
+		t.Fatalf("unexpected days: %v", days)
+	}
+}
+
+func TestYears(t *testing.T) {
+	withRegistry(t)
+	if year := DefaultYear(); year != 0 {
+		t.Errorf("expected no default year, got %d", year)
+	}
+	Register(2016, 2, newFake)
+	Register(2015, 1, newFake)
+	Register(2015, 3, newFake)
+	if years := Years(); !slices.Equal(years, []int{2015, 2016}) {
+		t.Errorf("unexpected years: %v", years)
+	}
+	if year := DefaultYear(); year != 2016 {
+		t.Errorf("expected default year 2016, got %d", year)
+	}
+	if days := Days(2015); !slices.Equal(days, []int{1, 3}) {
+		t.Errorf("unexpected days of 2015: %v", days)
+	}
+	if _, ok := Lookup(2016, 1); ok {
+		t.Error("expected 2016 day 1 to be unregistered")
+	}
+}
+
+func TestSolve_InvalidPart(t *testing.T) {
+	if _, err := Solve(t.Context(), &fakeSolver{}, 3); err == nil {
+		t.Fatal("expected error for invalid part")
+	}
+}
+
+// countingSolver counts how many times its input is parsed.
+type countingSolver struct {
+	parses int
+}
+
+func (s *countingSolver) Parse(path string) error                { s.parses++; return nil }
+func (s *countingSolver) Part1(ctx context.Context) (any, error) { return s.parses, nil }
+func (s *countingSolver) Part2(ctx context.Context) (any, error) { return s.parses, nil }
+
+func TestRun_UsesDefaultPath(t *testing.T) {
+	withRegistry(t)
+	t.Setenv(DataDirEnv, "")
+	Register(2015, 7, newFake)
+
+	results, err := Run(t.Context(), 7, Params{Parts: []int{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || results[0].Answer != "one:"+DefaultPath(DefaultDataDir, 2015, 7) {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+	if results[0].InputSHA256 != "" {
+		t.Fatalf("expected no hash for a missing input file, got %q", results[0].InputSHA256)
+	}
+}
+
+func TestRun_HashesInput(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 7, newFake)
+
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	results, err := Run(t.Context(), 7, Params{Path: path, Parts: []int{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// SHA-256 of an empty file
+	expected := "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	if results[0].InputSHA256 != expected {
+		t.Fatalf("expected %s, got %s", expected, results[0].InputSHA256)
+	}
+}
+
+func TestRun_ParsesOnce(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 7, func() Solver { return &countingSolver{} })
+
+	results, err := Run(t.Context(), 7, Params{Path: "input.txt", Parts: []int{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	for _, r := range results {
+		if r.Day != 7 || r.Err != nil || r.Answer != 1 {
+			t.Errorf("unexpected result: %+v", r)
+		}
+	}
+	if results[0].Part != 1 || results[1].Part != 2 {
+		t.Errorf("unexpected order of parts: %d, %d", results[0].Part, results[1].Part)
+	}
+}
+
+func TestRun_NoPart(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 7, newFake)
+	results, err := Run(t.Context(), 7, Params{Path: "input.txt", Parts: []int{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Err != nil {
+		t.Errorf("part 1: unexpected error %v", results[0].Err)
+	}
+	if !errors.Is(results[1].Err, ErrNoPart) {
+		t.Errorf("part 2: expected ErrNoPart, got %v", results[1].Err)
+	}
+}
+
+func TestRun_ParseError(t *testing.T) {
+	withRegistry(t)
+	parseErr := errors.New("bad input")
+	Register(2015, 7, func() Solver { return &fakeSolver{parseErr: parseErr} })
+	if _, err := Run(t.Context(), 7, Params{Path: "input.txt", Parts: []int{1}}); !errors.Is(err, parseErr) {
+		t.Fatalf("expected parse error, got %v", err)
+	}
+}
+
+func TestRun_Unregistered(t *testing.T) {
+	withRegistry(t)
+	if _, err := Run(t.Context(), 8, Params{Parts: []int{1}}); err == nil {
+		t.Fatal("expected error for unregistered day")
+	}
+}
+
+// configurableSolver answers with a limit that is read from the input and can be overridden by a flag.
+type configurableSolver struct {
+	limit int
+}
+
+func (s *configurableSolver) Parse(path string) error                { s.limit = 10; return nil }
+func (s *configurableSolver) Part1(ctx context.Context) (any, error) { return s.limit, nil }
+func (s *configurableSolver) Part2(ctx context.Context) (any, error) { return nil, ErrNoPart }
+
+func (s *configurableSolver) Flags(fs *flag.FlagSet) {
+	fs.IntVar(&s.limit, "limit", s.limit, "Limit (overrides the input)")
+}
+
+func (s *configurableSolver) Validate() error {
+	if s.limit < 1 {
+		return errors.New("the limit must be positive")
+	}
+	return nil
+}
+
+func TestRun_AppliesOverrides(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 7, func() Solver { return &configurableSolver{} })
+
+	params, _, err := Parameters(7, []string{"-limit", "42", "-part", "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.Overrides["limit"] != "42" {
+		t.Fatalf("unexpected overrides: %v", params.Overrides)
+	}
+	results, err := Run(t.Context(), 7, params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Answer != 42 {
+		t.Fatalf("expected 42, got %v", results[0].Answer)
+	}
+}
+
+func TestRun_NoOverrides(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 7, func() Solver { return &configurableSolver{} })
+
+	results, err := Run(t.Context(), 7, Params{Parts: []int{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Answer != 10 {
+		t.Fatalf("expected 10, got %v", results[0].Answer)
+	}
+}
+
+func TestRun_InvalidOverride(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 7, func() Solver { return &configurableSolver{} })
+	Register(2015, 8, newFake)
+
+	if _, err := Run(t.Context(), 7, Params{Parts: []int{1}, Overrides: map[string]string{"limit": "many"}}); err == nil {
+		t.Error("expected error for invalid override value")
+	}
+	if _, err := Run(t.Context(), 7, Params{Parts: []int{1}, Overrides: map[string]string{"limit": "0"}}); err == nil {
+		t.Error("expected error for an override that is not valid for the solver")
+	}
+	if _, err := Run(t.Context(), 8, Params{Parts: []int{1}, Overrides: map[string]string{"limit": "1"}}); err == nil {
+		t.Error("expected error for override of a solver without flags")
+	}
+}
+
+// inputSolver answers with a count that is read from its input file times a scale of 1, both of which can be
+// overridden by flags.
+type inputSolver struct {
+	count int
+	scale int
+}
+
+func (s *inputSolver) Parse(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	s.scale = 1
+	s.count, err = strconv.Atoi(strings.TrimSpace(string(data)))
+	return err
+}
+
+func (s *inputSolver) Part1(ctx context.Context) (any, error) { return s.count * s.scale, nil }
+func (s *inputSolver) Part2(ctx context.Context) (any, error) { return nil, ErrNoPart }
+
+func (s *inputSolver) Flags(fs *flag.FlagSet) {
+	fs.IntVar(&s.count, "count", s.count, "Count (overrides the input)")
+	fs.IntVar(&s.scale, "scale", s.scale, "Scale (overrides the input)")
+}
+
+func (s *inputSolver) Validate() error {
+	if s.count < 1 || s.scale < 1 {
+		return errors.New("the count and scale must be positive")
+	}
+	return nil
+}
+
+func TestRun_OverridesReplaceMissingInput(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 7, func() Solver { return &inputSolver{} })
+	dir := t.TempDir()
+
+	overrides := map[string]string{"count": "3", "scale": "2"}
+	results, err := Run(t.Context(), 7, Params{DataDir: dir, Parts: []int{1}, Overrides: overrides})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if results[0].Answer != 6 || results[0].InputSHA256 != "" {
+		t.Fatalf("expected 6 without an input, got %+v", results[0])
+	}
+
+	// The input is still needed for the values that are not overridden.
+	for _, overrides := range []map[string]string{nil, {"count": "3"}} {
+		if _, err := Run(t.Context(), 7, Params{DataDir: dir, Parts: []int{1}, Overrides: overrides}); !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("%v: expected a missing input, got %v", overrides, err)
+		}
+	}
+}
+
+func TestRun_OverridesReplaceInvalidInput(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 7, func() Solver { return &inputSolver{} })
+
+	tests := []struct {
+		input     string
+		overrides map[string]string
+		expected  any // Answer, or nil if the run fails
+	}{
+		{"0\n", nil, nil},
+		{"0\n", map[string]string{"count": "3"}, 3},
+		{"0\n", map[string]string{"scale": "2"}, nil},
+		{"many\n", map[string]string{"count": "3"}, nil},
+		{"many\n", map[string]string{"count": "3", "scale": "2"}, 6},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), "input.txt")
+		if err := os.WriteFile(path, []byte(tt.input), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		results, err := Run(t.Context(), 7, Params{Path: path, Parts: []int{1}, Overrides: tt.overrides})
+		switch {
+		case tt.expected == nil && err == nil:
+			t.Errorf("%q %v: expected an error, got %v", tt.input, tt.overrides, results[0].Answer)
+		case tt.expected != nil && (err != nil || results[0].Answer != tt.expected):
+			t.Errorf("%q %v: expected %v, got %v", tt.input, tt.overrides, tt.expected, err)
+		}
+	}
+}
+
+func TestParameters_UnknownSolverFlag(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 8, newFake)
+	if _, _, err := Parameters(8, []string{"-limit", "1"}); err == nil {
+		t.Fatal("expected error for a flag the solver does not define")
+	}
+}
+
+func TestRun_UsesDataDir(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 7, newFake)
+	Register(2016, 7, newFake)
+	t.Setenv(DataDirEnv, "/env")
+
+	tests := []struct {
+		params   Params
+		expected string
+	}{
+		{Params{Parts: []int{1}}, "/env/2016/day07/day07-input.txt"},
+		{Params{Year: 2015, Parts: []int{1}}, "/env/2015/day07/day07-input.txt"},
+		{Params{DataDir: "/root", Parts: []int{1}}, "/root/2016/day07/day07-input.txt"},
+		{Params{DataDir: "/root", Example: 2, Parts: []int{1}}, "/root/2016/day07/day07-example2.txt"},
+		{Params{DataDir: "/root", Path: "input.txt", Parts: []int{1}}, "input.txt"},
+	}
+	for _, tt := range tests {
+		results, err := Run(t.Context(), 7, tt.params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if results[0].Answer != "one:"+tt.expected {
+			t.Errorf("%+v: expected path %s, got %v", tt.params, tt.expected, results[0].Answer)
+		}
+	}
+}
+
+// panickingSolver panics in the given step: "parse", or the number of a part.
+type panickingSolver struct {
+	step string
+}
+
+func (s *panickingSolver) Parse(path string) error {
+	if s.step == "parse" {
+		panic("bad input")
+	}
+	return nil
+}
+
+func (s *panickingSolver) Part1(ctx context.Context) (any, error) {
+	if s.step == "1" {
+		var empty []int
+		return empty[0], nil
+	}
+	return 1, nil
+}
+
+func (s *panickingSolver) Part2(ctx context.Context) (any, error) { return 2, nil }
+
+func TestRun_Panics(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 1, func() Solver { return &panickingSolver{step: "parse"} })
+	Register(2015, 2, func() Solver { return &panickingSolver{step: "1"} })
+
+	var pe *PanicError
+	if _, err := Run(t.Context(), 1, Params{Path: "input.txt", Parts: []int{1}}); !errors.As(err, &pe) || pe.Value != "bad input" {
+		t.Errorf("expected a panic error, got %v", err)
+	}
+
+	results, err := Run(t.Context(), 2, Params{Path: "input.txt", Parts: []int{1, 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !errors.As(results[0].Err, &pe) || len(pe.Stack) == 0 {
+		t.Errorf("part 1: expected a panic error with a stack, got %v", results[0].Err)
+	}
+	if results[1].Err != nil || results[1].Answer != 2 {
+		t.Errorf("part 2: expected 2, got %v, %v", results[1].Answer, results[1].Err)
+	}
+}
@@ -1,25 +1,252 @@
 package setup
 
 import (
+	"errors"
 	"flag"
 	"fmt"
-	"log"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 )
 
-// Parameters parses command-line flags and returns the input file name and part number.
-func Parameters(day int) (path string, part int) {
-	defaultPath := fmt.Sprintf("data/day%02d/day%02d-input.txt", day, day)
-	pathFlag := flag.String("file", defaultPath, "Path to the input file")
-	partFlag := flag.Int("part", 1, "Part number (1 or 2)")
-	flag.Parse()
+// Params holds the parameters of a run.
+type Params struct {
+	Year      int               // Year of the puzzles, or 0 for DefaultYear()
+	DataDir   string            // Root directory of the puzzle data, or empty for DataDir()
+	Path      string            // Path to the input file, load.Stdin for the standard input, or empty for the default
+	Example   int               // Number of the example to use as the input, or 0 for the puzzle input
+	Parts     []int             // Parts to run, or nil if not specified
+	Output    string            // Output format (OutputText or OutputJSON)
+	Bench     int               // Number of timed runs of a benchmark, or 0 to solve the puzzle once
+	Jobs      int               // Number of days to run at the same time when all days are run
+	Timeout   time.Duration     // Time allowed for a run of a day, or 0 for no limit
+	LogLevel  slog.Level        // Level of the events of the solvers to log
+	Profiles  Profiles          // Profiles to write during the run
+	Overrides map[string]string // Values of the solver's own flags that were set, by flag name
+}
+
+// Configurable is implemented by a solver with settings that can be overridden by command-line flags, such as values
+// that are normally read from the puzzle input.
+type Configurable interface {
+	// Flags defines the flags of the solver in fs. The flags that are set are applied after the input is parsed.
+	Flags(fs *flag.FlagSet)
+	// Validate returns an error if a setting is invalid. It is called after the input is parsed and the flags are
+	// applied, so that an override is checked like the value read from the input, and can replace an invalid one.
+	Validate() error
+}
+
+// Parameters parses the command-line flags in args and returns the run parameters and the remaining arguments. If the
+// solver registered for the given day of the year selected by -year is Configurable, its flags are accepted too. Day 0
+// accepts only the common flags.
+func Parameters(day int, args []string) (Params, []string, error) {
+	// The year is needed to find the flags of the solver before the flags are parsed.
+	year, err := yearArg(args)
+	if err != nil {
+		return Params{}, nil, err
+	}
+
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	fs.IntVar(&year, "year", year, "Year of the puzzles")
+	dataFlag := fs.String("data", "", "Root `directory` of the puzzle data (default $AOC_DATA_DIR or data)")
+	pathFlag := fs.String("file", "", "Path to the input file, or - for stdin (default <data>/<year>/dayNN/dayNN-input.txt)")
+	partFlag := fs.String("part", "", "Part number (1, 2, or all)")
+	outputFlag := fs.String("output", OutputText, "Output format (text or json)")
+	benchFlag := fs.Int("bench", 0, "Benchmark the solver over `n` runs after a warm-up instead of solving once")
+	jobsFlag := fs.Int("j", 1, "Run up to `n` days at the same time when all days are run")
+	timeoutFlag := fs.Duration("timeout", 0, "Stop a run of a day that takes longer than `duration` (default no limit)")
+	var profiles Profiles
+	fs.StringVar(&profiles.CPU, "cpuprofile", "", "Write a CPU profile to `file`")
+	fs.StringVar(&profiles.Mem, "memprofile", "", "Write a heap profile to `file` after the run")
+	fs.StringVar(&profiles.Trace, "trace", "", "Write an execution trace to `file`")
+	var example exampleFlag
+	logLevel := levelFlag(slog.LevelInfo)
+	fs.Var(&logLevel, "v", "Log the events of the solver at `level` debug, or trace for every step (-v alone means debug)")
+	fs.Var(&example, "example", "Use example `n` as the input (-example alone means example 1)")
+
+	solverFlags := flag.NewFlagSet("solver", flag.ContinueOnError)
+	if solver, ok := Lookup(year, day); ok {
+		if c, ok := solver.(Configurable); ok {
+			c.Flags(solverFlags)
+		}
+	}
+	solverFlags.VisitAll(func(f *flag.Flag) { fs.Var(f.Value, f.Name, f.Usage) })
+
+	if err := fs.Parse(args); err != nil {
+		return Params{}, nil, err
+	}
+
+	var overrides map[string]string
+	fs.Visit(func(f *flag.Flag) {
+		if solverFlags.Lookup(f.Name) != nil {
+			if overrides == nil {
+				overrides = make(map[string]string)
+			}
+			overrides[f.Name] = f.Value.String()
+		}
+	})
+
+	var parts []int
+	switch *partFlag {
+	case "":
+	case "1":
+		parts = []int{1}
+	case "2":
+		parts = []int{2}
+	case "all":
+		parts = []int{1, 2}
+	default:
+		return Params{}, nil, errors.New("invalid part specified, must be 1, 2, or all")
+	}
+	if *outputFlag != OutputText && *outputFlag != OutputJSON {
+		return Params{}, nil, errors.New("invalid output format specified, must be text or json")
+	}
+	if *benchFlag < 0 {
+		return Params{}, nil, errors.New("invalid number of benchmark runs, must be positive")
+	}
+	if *jobsFlag < 1 {
+		return Params{}, nil, errors.New("invalid number of jobs, must be positive")
+	}
+	if *timeoutFlag < 0 {
+		return Params{}, nil, errors.New("invalid timeout, must be positive")
+	}
+	if *pathFlag != "" && example != 0 {
+		return Params{}, nil, errors.New("-file and -example cannot be used together")
+	}
+	if *dataFlag == "" {
+		*dataFlag = DataDir()
+	}
+	params := Params{
+		Year:      year,
+		DataDir:   *dataFlag,
+		Path:      *pathFlag,
+		Example:   int(example),
+		Parts:     parts,
+		Output:    *outputFlag,
+		Bench:     *benchFlag,
+		Jobs:      *jobsFlag,
+		Timeout:   *timeoutFlag,
+		LogLevel:  slog.Level(logLevel),
+		Profiles:  profiles,
+		Overrides: overrides,
+	}
+	return params, fs.Args(), nil
+}
+
+// yearArg returns the value of the -year flag in args, or DefaultYear() if it is not set. Only the flags before the
+// first argument that is not a flag are searched, as flag.FlagSet.Parse does.
+func yearArg(args []string) (int, error) {
+	year := DefaultYear()
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" || !strings.HasPrefix(arg, "-") || arg == "-" {
+			break
+		}
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if name != "year" {
+			continue
+		}
+		if !hasValue {
+			if i+1 == len(args) {
+				break // flag.FlagSet.Parse reports the missing value
+			}
+			i++
+			value = args[i]
+		}
+		y, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid value %q for flag -year", value)
+		}
+		year = y
+	}
+	return year, nil
+}
+
+// year returns the year of the parameters, or DefaultYear() if it is not set.
+func (p Params) year() int {
+	if p.Year != 0 {
+		return p.Year
+	}
+	return DefaultYear()
+}
+
+// exampleFlag is the number of an example. It can be set without a value, which selects example 1.
+type exampleFlag int
+
+func (e *exampleFlag) String() string { return strconv.Itoa(int(*e)) }
+
+func (e *exampleFlag) Set(value string) error {
+	switch value {
+	case "true":
+		*e = 1
+	case "false":
+		*e = 0
+	default:
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			return errors.New("must be a positive number")
+		}
+		*e = exampleFlag(n)
+	}
+	return nil
+}
+
+func (e *exampleFlag) IsBoolFlag() bool { return true }
+
+// DataDirEnv is the environment variable that sets the root directory of the puzzle data.
+const DataDirEnv = "AOC_DATA_DIR"
+
+// DefaultDataDir is the root directory of the puzzle data if it is not set otherwise.
+const DefaultDataDir = "data"
 
-	if *partFlag != 1 && *partFlag != 2 {
-		log.Fatal("Invalid part specified. Must be 1 or 2.")
+// DataDir returns the root directory of the puzzle data set by the AOC_DATA_DIR environment variable, or
+// DefaultDataDir if the variable is not set.
+func DataDir() string {
+	if dir := os.Getenv(DataDirEnv); dir != "" {
+		return dir
 	}
-	return *pathFlag, *partFlag
+	return DefaultDataDir
 }
 
-// Banner prints a banner showing the current day and part.
-func Banner(day int, part int) {
-	fmt.Printf("=== Day %d - Part %d ===\n", day, part)
+// DayDir returns the directory of the data of the given day of the given year in the data directory dir.
+func DayDir(dir string, year int, day int) string {
+	return filepath.Join(dir, strconv.Itoa(year), fmt.Sprintf("day%02d", day))
+}
+
+// DefaultPath returns the default path of the input file for the given day of the given year in the data directory
+// dir.
+func DefaultPath(dir string, year int, day int) string {
+	return filepath.Join(DayDir(dir, year, day), fmt.Sprintf("day%02d-input.txt", day))
+}
+
+// ExamplePath returns the path of the input file of the given example of the given day of the given year in the data
+// directory dir. Examples are numbered from 1.
+func ExamplePath(dir string, year int, day int, example int) string {
+	return filepath.Join(DayDir(dir, year, day), fmt.Sprintf("day%02d-example%d.txt", day, example))
+}
+
+// ExampleAnswersPath returns the path of the expected answers of the given example of the given day of the given year
+// in the data directory dir. The file is optional.
+func ExampleAnswersPath(dir string, year int, day int, example int) string {
+	return filepath.Join(DayDir(dir, year, day), fmt.Sprintf("day%02d-example%d-answers.txt", day, example))
+}
+
+// Banner prints a banner showing the current year, day, and part. If part is 0, the banner shows only the year and
+// day.
+func Banner(year int, day int, part int) {
+	banner(os.Stdout, year, day, part)
+}
+
+// banner writes a banner showing the year, day, and part to w. If part is 0, the banner shows only the year and day.
+func banner(w io.Writer, year int, day int, part int) error {
+	var err error
+	if part == 0 {
+		_, err = fmt.Fprintf(w, "=== %d Day %d ===\n", year, day)
+	} else {
+		_, err = fmt.Fprintf(w, "=== %d Day %d - Part %d ===\n", year, day, part)
+	}
+	return err
 }
@@ -2,17 +2,18 @@ package setup
 
 import (
 	"bytes"
-	"fmt"
 	"os"
+	"slices"
 	"testing"
+	"time"
 )
 
 // Banner tests
 
 func TestBanner_Day1Part1(t *testing.T) {
 	var buf bytes.Buffer
-	fmt.Fprintf(&buf, "=== Day %d - Part %d ===\n", 1, 1)
-	expected := "=== Day 1 - Part 1 ===\n"
+	banner(&buf, 2015, 1, 1)
+	expected := "=== 2015 Day 1 - Part 1 ===\n"
 	if buf.String() != expected {
 		t.Fatalf("expected %q, got %q", expected, buf.String())
 	}
@@ -20,8 +21,8 @@ func TestBanner_Day1Part1(t *testing.T) {
 
 func TestBanner_Day25Part2(t *testing.T) {
 	var buf bytes.Buffer
-	fmt.Fprintf(&buf, "=== Day %d - Part %d ===\n", 25, 2)
-	expected := "=== Day 25 - Part 2 ===\n"
+	banner(&buf, 2015, 25, 2)
+	expected := "=== 2015 Day 25 - Part 2 ===\n"
 	if buf.String() != expected {
 		t.Fatalf("expected %q, got %q", expected, buf.String())
 	}
@@ -33,38 +34,236 @@ func TestBanner_CaptureOutput(t *testing.T) {
 	r, w, _ := os.Pipe()
 	os.Stdout = w
 
-	Banner(12, 2)
+	Banner(2015, 12, 2)
 
 	w.Close()
 	os.Stdout = old
 
 	var buf bytes.Buffer
 	buf.ReadFrom(r)
-	expected := "=== Day 12 - Part 2 ===\n"
+	expected := "=== 2015 Day 12 - Part 2 ===\n"
+	if buf.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestBanner_DayOnly(t *testing.T) {
+	old := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	Banner(2015, 7, 0)
+
+	w.Close()
+	os.Stdout = old
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	expected := "=== 2015 Day 7 ===\n"
 	if buf.String() != expected {
 		t.Fatalf("expected %q, got %q", expected, buf.String())
 	}
 }
 
 // Parameters tests
-// Note: Parameters calls flag.Parse(), which makes it difficult to test in a
-// standard unit test without manipulating os.Args and the flag package's global
-// state. The tests below verify the default path format logic instead.
 
-func TestDefaultPathFormat(t *testing.T) {
+func TestParameters_Defaults(t *testing.T) {
+	params, rest, err := Parameters(0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.Path != "" || params.Parts != nil || params.Output != OutputText || len(rest) != 0 {
+		t.Fatalf("unexpected result: %+v %v", params, rest)
+	}
+}
+
+func TestParameters_Flags(t *testing.T) {
+	params, rest, err := Parameters(0, []string{"-file", "input.txt", "--part", "2", "extra"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.Path != "input.txt" || !slices.Equal(params.Parts, []int{2}) {
+		t.Fatalf("unexpected params: %+v", params)
+	}
+	if len(rest) != 1 || rest[0] != "extra" {
+		t.Fatalf("unexpected remaining arguments: %v", rest)
+	}
+}
+
+func TestParameters_AllParts(t *testing.T) {
+	params, _, err := Parameters(0, []string{"-part", "all"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(params.Parts, []int{1, 2}) {
+		t.Fatalf("unexpected parts: %v", params.Parts)
+	}
+}
+
+func TestParameters_Output(t *testing.T) {
+	params, _, err := Parameters(0, []string{"-output", "json"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.Output != OutputJSON {
+		t.Fatalf("unexpected output format: %q", params.Output)
+	}
+	if _, _, err := Parameters(0, []string{"-output", "xml"}); err == nil {
+		t.Fatal("expected error for invalid output format")
+	}
+}
+
+func TestParameters_InvalidPart(t *testing.T) {
+	for _, part := range []string{"0", "3", "both"} {
+		if _, _, err := Parameters(0, []string{"-part", part}); err == nil {
+			t.Errorf("%s: expected error for invalid part", part)
+		}
+	}
+}
+
+func TestDefaultPath(t *testing.T) {
 	tests := []struct {
 		day      int
 		expected string
 	}{
-		{1, "data/day01/day01-input.txt"},
-		{9, "data/day09/day09-input.txt"},
-		{10, "data/day10/day10-input.txt"},
-		{25, "data/day25/day25-input.txt"},
+		{1, "data/2015/day01/day01-input.txt"},
+		{9, "data/2015/day09/day09-input.txt"},
+		{10, "data/2015/day10/day10-input.txt"},
+		{25, "data/2015/day25/day25-input.txt"},
 	}
 	for _, tt := range tests {
-		result := fmt.Sprintf("data/day%02d/day%02d-input.txt", tt.day, tt.day)
+		result := DefaultPath(DefaultDataDir, 2015, tt.day)
 		if result != tt.expected {
 			t.Errorf("day %d: expected %q, got %q", tt.day, tt.expected, result)
 		}
 	}
 }
+
+func TestParameters_Example(t *testing.T) {
+	tests := []struct {
+		args     []string
+		expected int
+	}{
+		{nil, 0},
+		{[]string{"-example"}, 1},
+		{[]string{"-example=3"}, 3},
+		{[]string{"-example", "-part", "2"}, 1},
+	}
+	for _, tt := range tests {
+		params, _, err := Parameters(0, tt.args)
+		if err != nil {
+			t.Fatalf("%v: %v", tt.args, err)
+		}
+		if params.Example != tt.expected {
+			t.Errorf("%v: expected example %d, got %d", tt.args, tt.expected, params.Example)
+		}
+	}
+}
+
+func TestParameters_InvalidExample(t *testing.T) {
+	for _, args := range [][]string{{"-example=0"}, {"-example=x"}, {"-example", "-file", "input.txt"}} {
+		if _, _, err := Parameters(0, args); err == nil {
+			t.Errorf("%v: expected error", args)
+		}
+	}
+}
+
+func TestExamplePaths(t *testing.T) {
+	if path := ExamplePath("data", 2015, 7, 1); path != "data/2015/day07/day07-example1.txt" {
+		t.Errorf("unexpected example path %q", path)
+	}
+	if path := ExampleAnswersPath("data", 2016, 12, 2); path != "data/2016/day12/day12-example2-answers.txt" {
+		t.Errorf("unexpected example answers path %q", path)
+	}
+}
+
+func TestDataDir(t *testing.T) {
+	t.Setenv(DataDirEnv, "")
+	if dir := DataDir(); dir != DefaultDataDir {
+		t.Errorf("expected %s, got %s", DefaultDataDir, dir)
+	}
+	t.Setenv(DataDirEnv, "/puzzles")
+	if dir := DataDir(); dir != "/puzzles" {
+		t.Errorf("expected /puzzles, got %s", dir)
+	}
+}
+
+func TestParameters_DataDir(t *testing.T) {
+	t.Setenv(DataDirEnv, "/puzzles")
+	params, _, err := Parameters(0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.DataDir != "/puzzles" {
+		t.Errorf("expected /puzzles, got %s", params.DataDir)
+	}
+	params, _, err = Parameters(0, []string{"-data", "/other"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.DataDir != "/other" {
+		t.Errorf("expected /other, got %s", params.DataDir)
+	}
+}
+
+func TestParameters_Profiles(t *testing.T) {
+	params, _, err := Parameters(0, []string{"-cpuprofile", "cpu.out", "-memprofile", "mem.out", "-trace", "trace.out"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Profiles{CPU: "cpu.out", Mem: "mem.out", Trace: "trace.out"}
+	if params.Profiles != expected {
+		t.Errorf("expected %+v, got %+v", expected, params.Profiles)
+	}
+}
+
+func TestParameters_Timeout(t *testing.T) {
+	params, _, err := Parameters(0, []string{"-timeout", "1m30s"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.Timeout != 90*time.Second {
+		t.Errorf("expected 1m30s, got %v", params.Timeout)
+	}
+	if _, _, err := Parameters(0, []string{"-timeout", "-1s"}); err == nil {
+		t.Error("expected error for a negative timeout")
+	}
+}
+
+func TestParameters_Year(t *testing.T) {
+	withRegistry(t)
+	Register(2015, 7, newFake)
+	Register(2016, 7, func() Solver { return &configurableSolver{} })
+
+	params, _, err := Parameters(7, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.Year != 2016 {
+		t.Errorf("expected the latest year 2016, got %d", params.Year)
+	}
+
+	// Only the solver of the selected year accepts its own flags.
+	if _, _, err := Parameters(7, []string{"-year", "2016", "-limit", "3"}); err != nil {
+		t.Errorf("2016: unexpected error %v", err)
+	}
+	if _, _, err := Parameters(7, []string{"-year=2015", "-limit", "3"}); err == nil {
+		t.Error("2015: expected error for an unknown flag")
+	}
+	if _, _, err := Parameters(7, []string{"-year", "x"}); err == nil {
+		t.Error("expected error for an invalid year")
+	}
+}
+
+func TestParameters_Jobs(t *testing.T) {
+	params, _, err := Parameters(0, []string{"-j", "4"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params.Jobs != 4 {
+		t.Errorf("expected 4 jobs, got %d", params.Jobs)
+	}
+	if _, _, err := Parameters(0, []string{"-j", "0"}); err == nil {
+		t.Error("expected error for no jobs")
+	}
+}
@@ -0,0 +1,33 @@
+// Package setuptest provides helpers for testing solvers.
+package setuptest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+// Input writes the content to a temporary file and returns its path.
+func Input(t testing.TB, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// Solve parses the input with the solver and returns the answer to the given part. The test fails on any error.
+func Solve(t testing.TB, solver setup.Solver, input string, part int) any {
+	t.Helper()
+	if err := solver.Parse(Input(t, input)); err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	answer, err := setup.Solve(t.Context(), solver, part)
+	if err != nil {
+		t.Fatalf("part %d: %v", part, err)
+	}
+	return answer
+}
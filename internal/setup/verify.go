@@ -0,0 +1,155 @@
+package setup
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+)
+
+// AnswersPath returns the default path of the expected answers file of the given year in the data directory dir.
+func AnswersPath(dir string, year int) string {
+	return filepath.Join(dir, strconv.Itoa(year), "answers.txt")
+}
+
+// Key identifies one part of a day's puzzle.
+type Key struct {
+	Year int
+	Day  int
+	Part int
+}
+
+// Answers maps each part of each day to its expected answer.
+type Answers map[Key]string
+
+// LoadAnswers reads the expected answers of the given year from the file at path. Each line has the form
+// "day part answer". Blank lines and lines starting with # are ignored.
+func LoadAnswers(path string, year int) (Answers, error) {
+	return loadAnswers(path, year, 0)
+}
+
+// LoadExampleAnswers reads the expected answers of an example of the given day of the given year from the file at
+// path. Each line has the form "part answer". Blank lines and lines starting with # are ignored.
+func LoadExampleAnswers(path string, year int, day int) (Answers, error) {
+	return loadAnswers(path, year, day)
+}
+
+// loadAnswers reads expected answers of the given year from the file at path. If day is 0, each line includes the day.
+// Otherwise, the lines omit the day and the answers are for the given day.
+func loadAnswers(path string, year int, day int) (Answers, error) {
+	lines, err := load.Numbered(path)
+	if err != nil {
+		return nil, err
+	}
+
+	format := "day part answer"
+	if day != 0 {
+		format = "part answer"
+	}
+	n := len(strings.Fields(format))
+
+	answers := make(Answers)
+	for _, line := range lines {
+		fields, columns := line.Fields()
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) != n {
+			return nil, line.Errorf(columns[0], strings.TrimSpace(line.Text), "expected %q", format)
+		}
+		key := Key{Year: year, Day: day}
+		if day == 0 {
+			if key.Day, err = strconv.Atoi(fields[0]); err != nil {
+				return nil, line.Errorf(columns[0], fields[0], "invalid day")
+			}
+			fields, columns = fields[1:], columns[1:]
+		}
+		if key.Part, err = strconv.Atoi(fields[0]); err != nil {
+			return nil, line.Errorf(columns[0], fields[0], "invalid part")
+		}
+		answers[key] = fields[1]
+	}
+	return answers, nil
+}
+
+// CheckExample checks the results against the expected answers of the given example of the day of the given year in
+// the data directory dir. If the example has no answers file, nil is returned.
+func CheckExample(dir string, year int, day int, example int, results []Result) ([]Check, error) {
+	answers, err := LoadExampleAnswers(ExampleAnswersPath(dir, year, day, example), year, day)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return Verify(results, answers), nil
+}
+
+// Status is the outcome of checking a result against the expected answers.
+type Status string
+
+// Statuses of a check
+const (
+	Pass    Status = "PASS"    // The answer matches the expected answer.
+	Fail    Status = "FAIL"    // The answer does not match the expected answer, or the part failed.
+	Missing Status = "MISSING" // There is no expected answer for the part.
+)
+
+// Check is the outcome of checking one result against the expected answers.
+type Check struct {
+	Result
+	Status   Status
+	Expected string // The expected answer, if any
+}
+
+// Verify checks each of the results against the expected answers. A part that the puzzle does not have is skipped
+// when multiple parts are run.
+func Verify(results []Result, answers Answers) []Check {
+	checks := make([]Check, 0, len(results))
+	for _, r := range results {
+		if omitted(results, r) {
+			continue
+		}
+		expected, ok := answers[Key{r.Year, r.Day, r.Part}]
+		c := Check{Result: r, Expected: expected}
+		switch {
+		case r.Err != nil:
+			c.Status = Fail
+		case !ok:
+			c.Status = Missing
+		case fmt.Sprint(r.Answer) == expected:
+			c.Status = Pass
+		default:
+			c.Status = Fail
+		}
+		checks = append(checks, c)
+	}
+	return checks
+}
+
+// WriteChecks writes one line for each check to w. A failure shows the difference between the expected and actual
+// answers.
+func WriteChecks(w io.Writer, checks []Check) error {
+	for _, c := range checks {
+		var detail string
+		switch {
+		case c.Err != nil && c.Expected != "":
+			detail = fmt.Sprintf("expected %s, got error: %v", c.Expected, c.Err)
+		case c.Err != nil:
+			detail = fmt.Sprintf("error: %v", c.Err)
+		case c.Status == Fail:
+			detail = fmt.Sprintf("expected %s, got %v", c.Expected, c.Answer)
+		default:
+			detail = fmt.Sprint(c.Answer)
+		}
+		if _, err := fmt.Fprintf(w, "%-7s %d day %2d part %d: %s\n", c.Status, c.Year, c.Day, c.Part, detail); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package setup
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jambolo/advent-of-code-2015/internal/load"
+)
+
+func writeAnswers(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "answers.txt")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadAnswers(t *testing.T) {
+	path := writeAnswers(t, "# comment\n\n1 1 280\n11 2 cqkaabcc\n")
+	answers, err := LoadAnswers(path, 2015)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(answers) != 2 || answers[Key{2015, 1, 1}] != "280" || answers[Key{2015, 11, 2}] != "cqkaabcc" {
+		t.Fatalf("unexpected answers: %v", answers)
+	}
+}
+
+func TestLoadAnswers_Invalid(t *testing.T) {
+	tests := []struct {
+		content string
+		line    int
+		column  int
+	}{
+		{"1 1\n", 1, 1},
+		{"x 1 280\n", 1, 1},
+		{"# comment\n1  y 280\n", 2, 4},
+	}
+	for _, tt := range tests {
+		_, err := LoadAnswers(writeAnswers(t, tt.content), 2015)
+		var pe *load.ParseError
+		if !errors.As(err, &pe) || pe.Line != tt.line || pe.Column != tt.column {
+			t.Errorf("%q: expected an error at %d:%d, got %v", tt.content, tt.line, tt.column, err)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	answers := Answers{{2015, 1, 1}: "280", {2015, 1, 2}: "1797", {2015, 2, 1}: "58"}
+	results := []Result{
+		{Year: 2015, Day: 1, Part: 1, Answer: 280},
+		{Year: 2015, Day: 1, Part: 2, Answer: 1796},
+	}
+	checks := Verify(results, answers)
+	if len(checks) != 2 || checks[0].Status != Pass || checks[1].Status != Fail {
+		t.Fatalf("unexpected checks: %+v", checks)
+	}
+
+	// The answers of another year do not apply.
+	checks = Verify([]Result{{Year: 2016, Day: 1, Part: 1, Answer: 280}}, answers)
+	if len(checks) != 1 || checks[0].Status != Missing {
+		t.Fatalf("unexpected checks: %+v", checks)
+	}
+
+	results = []Result{
+		{Year: 2015, Day: 2, Part: 1, Err: errors.New("boom")},
+		{Year: 2015, Day: 2, Part: 2, Answer: 34},
+	}
+	checks = Verify(results, answers)
+	if len(checks) != 2 || checks[0].Status != Fail || checks[1].Status != Missing {
+		t.Fatalf("unexpected checks: %+v", checks)
+	}
+}
+
+func TestVerify_SkipsMissingPart(t *testing.T) {
+	answers := Answers{{2015, 25, 1}: "8997277"}
+	results := []Result{
+		{Year: 2015, Day: 25, Part: 1, Answer: 8997277},
+		{Year: 2015, Day: 25, Part: 2, Err: ErrNoPart},
+	}
+	checks := Verify(results, answers)
+	if len(checks) != 1 || checks[0].Status != Pass {
+		t.Fatalf("unexpected checks: %+v", checks)
+	}
+}
+
+func TestWriteChecks(t *testing.T) {
+	checks := []Check{
+		{Result: Result{Year: 2015, Day: 1, Part: 1, Answer: 280}, Status: Pass, Expected: "280"},
+		{Result: Result{Year: 2015, Day: 7, Part: 2, Answer: 14135}, Status: Fail, Expected: "14134"},
+		{Result: Result{Year: 2015, Day: 9, Part: 1, Answer: 141}, Status: Missing},
+	}
+	var buf bytes.Buffer
+	if err := WriteChecks(&buf, checks); err != nil {
+		t.Fatal(err)
+	}
+	expected := "PASS    2015 day  1 part 1: 280\n" +
+		"FAIL    2015 day  7 part 2: expected 14134, got 14135\n" +
+		"MISSING 2015 day  9 part 1: 141\n"
+	if buf.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestLoadExampleAnswers(t *testing.T) {
+	answers, err := LoadExampleAnswers(writeAnswers(t, "# part answer\n1 12\n2 19\n"), 2015, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(answers) != 2 || answers[Key{2015, 8, 1}] != "12" || answers[Key{2015, 8, 2}] != "19" {
+		t.Fatalf("unexpected answers: %v", answers)
+	}
+	if _, err := LoadExampleAnswers(writeAnswers(t, "8 1 12\n"), 2015, 8); err == nil {
+		t.Fatal("expected error for a line with a day")
+	}
+}
+
+func TestCheckExample(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := os.MkdirAll("data/2015/day08", 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(ExampleAnswersPath("data", 2015, 8, 1), []byte("1 12\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	results := []Result{{Year: 2015, Day: 8, Part: 1, Answer: 12}}
+	checks, err := CheckExample("data", 2015, 8, 1, results)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(checks) != 1 || checks[0].Status != Pass {
+		t.Fatalf("unexpected checks: %+v", checks)
+	}
+
+	// An example without an answers file is not checked.
+	checks, err = CheckExample("data", 2015, 8, 2, results)
+	if err != nil || checks != nil {
+		t.Fatalf("expected no checks, got %+v, %v", checks, err)
+	}
+}
@@ -0,0 +1,156 @@
+// Package watch runs a day again whenever its source files or input change.
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+// Watcher polls the files in a set of directories and runs a day when any of them changes.
+type Watcher struct {
+	Title    string        // Title of the day, which is shown in the banner of each run
+	Dirs     []string      // Directories whose files are polled, which do not need to exist
+	Interval time.Duration // Time between polls
+	Out      io.Writer     // Writer of the answers of each run
+
+	// Run runs the day and returns its results.
+	Run func(ctx context.Context) ([]setup.Result, error)
+}
+
+// Watch runs the day, and then runs it again each time the files change, showing the answers and how they differ from
+// the previous run. It returns when ctx is done.
+func (w *Watcher) Watch(ctx context.Context) error {
+	state, err := snapshot(w.Dirs)
+	if err != nil {
+		return err
+	}
+	prev := w.run(ctx, nil)
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		next, err := snapshot(w.Dirs)
+		if err != nil {
+			return err
+		}
+		changed := changes(state, next)
+		if len(changed) == 0 {
+			continue
+		}
+		state = next
+		for _, path := range changed {
+			fmt.Fprintln(w.Out, "changed", path)
+		}
+		prev = w.run(ctx, prev)
+	}
+}
+
+// run runs the day and shows its answers compared to the previous results. It returns the new results, or the previous
+// results if the run fails, so that the next run is compared to the last one that succeeded.
+func (w *Watcher) run(ctx context.Context, prev []setup.Result) []setup.Result {
+	fmt.Fprintf(w.Out, "=== %s at %s ===\n", w.Title, time.Now().Format(time.TimeOnly))
+	results, err := w.Run(ctx)
+	if ctx.Err() != nil {
+		return prev
+	}
+	if err != nil {
+		fmt.Fprintf(w.Out, "Error: %v\n", err)
+		return prev
+	}
+	for _, line := range Diff(prev, results) {
+		fmt.Fprintln(w.Out, line)
+	}
+	return results
+}
+
+// file is the state of a file that is polled.
+type file struct {
+	size    int64
+	modTime time.Time
+}
+
+// snapshot returns the state of each regular file in the directories, by path. A directory that does not exist has no
+// files.
+func snapshot(dirs []string) (map[string]file, error) {
+	files := make(map[string]file)
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.Type().IsRegular() {
+				continue
+			}
+			info, err := entry.Info()
+			if os.IsNotExist(err) {
+				continue // The file was removed after the directory was read.
+			}
+			if err != nil {
+				return nil, err
+			}
+			files[filepath.Join(dir, entry.Name())] = file{size: info.Size(), modTime: info.ModTime()}
+		}
+	}
+	return files, nil
+}
+
+// changes returns the paths of the files that were added, removed, or modified between two snapshots, in sorted order.
+func changes(before map[string]file, after map[string]file) []string {
+	var paths []string
+	for path, f := range after {
+		if b, ok := before[path]; !ok || b != f {
+			paths = append(paths, path)
+		}
+	}
+	for path := range before {
+		if _, ok := after[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+	return paths
+}
+
+// Diff returns a line for each of the results showing its answer, or its error, and how it differs from the result of
+// the same part in prev.
+func Diff(prev []setup.Result, results []setup.Result) []string {
+	lines := make([]string, 0, len(results))
+	for _, r := range results {
+		line := fmt.Sprintf("Part %d: %s", r.Part, outcome(r))
+		i := slices.IndexFunc(prev, func(p setup.Result) bool { return p.Part == r.Part })
+		switch {
+		case i < 0:
+		case outcome(prev[i]) == outcome(r):
+			line += " (unchanged)"
+		default:
+			line += fmt.Sprintf(" (was %s)", outcome(prev[i]))
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// outcome returns the answer of the result, or its error.
+func outcome(r setup.Result) string {
+	if r.Err != nil {
+		return "error: " + r.Err.Error()
+	}
+	return fmt.Sprint(r.Answer)
+}
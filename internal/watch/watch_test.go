@@ -0,0 +1,132 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jambolo/advent-of-code-2015/internal/setup"
+)
+
+func TestChanges(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.go")
+	b := filepath.Join(dir, "b.txt")
+	write := func(path string, content string) {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(a, "package a")
+	write(b, "1 2 3")
+	missing := filepath.Join(dir, "missing")
+
+	before, err := snapshot([]string{dir, missing})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(before) != 2 {
+		t.Fatalf("expected 2 files, got %v", before)
+	}
+	after, _ := snapshot([]string{dir, missing})
+	if changed := changes(before, after); len(changed) != 0 {
+		t.Errorf("expected no changes, got %v", changed)
+	}
+
+	write(a, "package a // changed")
+	if err := os.Remove(b); err != nil {
+		t.Fatal(err)
+	}
+	c := filepath.Join(dir, "c.txt")
+	write(c, "4 5 6")
+	after, _ = snapshot([]string{dir, missing})
+	if changed := changes(before, after); !slices.Equal(changed, []string{a, b, c}) {
+		t.Errorf("expected %v, got %v", []string{a, b, c}, changed)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	prev := []setup.Result{{Part: 1, Answer: 46065}, {Part: 2, Answer: 14134}}
+	results := []setup.Result{{Part: 1, Answer: 46065}, {Part: 2, Err: errors.New("boom")}}
+	expected := []string{"Part 1: 46065 (unchanged)", "Part 2: error: boom (was 14134)"}
+	if lines := Diff(prev, results); !slices.Equal(lines, expected) {
+		t.Errorf("expected %q, got %q", expected, lines)
+	}
+	if lines := Diff(nil, prev); !slices.Equal(lines, []string{"Part 1: 46065", "Part 2: 14134"}) {
+		t.Errorf("unexpected first run %q", lines)
+	}
+}
+
+// syncBuffer is a buffer that can be written and read at the same time.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestWatch(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "input.txt")
+	if err := os.WriteFile(input, []byte("1"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The answer is the content of the input.
+	runs := make(chan struct{}, 10)
+	var out syncBuffer
+	w := &Watcher{
+		Title:    "2015 Day 7",
+		Dirs:     []string{dir},
+		Interval: 5 * time.Millisecond,
+		Out:      &out,
+		Run: func(ctx context.Context) ([]setup.Result, error) {
+			defer func() { runs <- struct{}{} }()
+			content, err := os.ReadFile(input)
+			return []setup.Result{{Part: 1, Answer: string(content)}}, err
+		},
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan error)
+	go func() { done <- w.Watch(ctx) }()
+
+	<-runs
+	if err := os.WriteFile(input, []byte("22"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-runs:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a run after the input changed")
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	text := out.String()
+	for _, want := range []string{"=== 2015 Day 7 at ", "Part 1: 1\n", "changed " + input, "Part 1: 22 (was 1)"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("expected %q in output:\n%s", want, text)
+		}
+	}
+}